@@ -7,6 +7,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/PrototypeSirius/protos_service/grpcstatus"
 	"github.com/PrototypeSirius/ruglogger/apperror"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -14,6 +15,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// errorDomain names the service in the ErrorInfo of its statuses.
+const errorDomain = "bot.cybergarden"
+
 type App struct {
 	log        *logrus.Logger
 	grpcServer *grpc.Server
@@ -21,7 +25,7 @@ type App struct {
 }
 
 func New(log *logrus.Logger, serverAPI grpchandler.BotService, port int) *App {
-	opts := []grpc.ServerOption{grpc.UnaryInterceptor(loggingInterceptor(log))}
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(loggingInterceptor(log), grpcstatus.UnaryServerInterceptor(errorDomain))}
 	gRPCServer := grpc.NewServer(opts...)
 	grpchandler.Register(gRPCServer, serverAPI)
 	return &App{log: log, grpcServer: gRPCServer, port: port}
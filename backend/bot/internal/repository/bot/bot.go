@@ -3,6 +3,7 @@ package botrepo
 import (
 	"bot/internal/models"
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/PrototypeSirius/ruglogger/apperror"
@@ -22,7 +23,7 @@ func New(bot *bot.Bot, token string) *Bot {
 func (b *Bot) Auth(ctx context.Context, authData string) (models.UserResponse, error) {
 	expIn := 24 * time.Hour
 	if err := initdata.Validate(authData, b.Token, expIn); err != nil {
-		return models.UserResponse{Authorized: false, UserID: 0, Error: err.Error()}, apperror.BadRequestError(err, 1081, "Invalid or expired authorization data.")
+		return models.UserResponse{Authorized: false, UserID: 0, Error: err.Error()}, apperror.CustomError(err, http.StatusUnauthorized, 1081, "Invalid or expired authorization data.")
 	}
 	initData, err := initdata.Parse(authData)
 	if err != nil {
@@ -25,7 +25,7 @@ func main() {
 	}()
 	log.Info("Config has been successfully loaded")
 
-	application, closeDB := app.New(log, cfg.Database, cfg.GRPC.Port)
+	application, closeDB := app.New(log, cfg.Database, cfg.Rates, cfg.GRPC.Port)
 	log.Info("Application has been successfully initialized.")
 	go func() {
 		application.GRPCServer.MustRun()
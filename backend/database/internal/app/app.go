@@ -13,9 +13,12 @@ type App struct {
 	GRPCServer *grpcapp.App
 }
 
-func New(log *logrus.Logger, cfg config.DatabaseConfig, port int) (*App, func() error) {
+func New(log *logrus.Logger, cfg config.DatabaseConfig, rates config.RatesConfig, port int) (*App, func() error) {
 	dbrepo, closeDB := dbrepo.New(log, cfg)
 	dbservice := dbservice.New(log, dbrepo)
+	if rates.Path != "" {
+		loadExchangeRates(log, dbservice, rates.Path)
+	}
 	grpcapp := grpcapp.New(log, dbservice, port)
 	return &App{GRPCServer: grpcapp}, closeDB
 }
@@ -7,6 +7,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/PrototypeSirius/protos_service/grpcstatus"
 	"github.com/PrototypeSirius/ruglogger/apperror"
 	"github.com/PrototypeSirius/ruglogger/logger"
 	"github.com/sirupsen/logrus"
@@ -15,6 +16,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// errorDomain names the service in the ErrorInfo of its statuses.
+const errorDomain = "database.cybergarden"
+
 type App struct {
 	log        *logrus.Logger
 	grpcServer *grpc.Server
@@ -22,7 +26,7 @@ type App struct {
 }
 
 func New(log *logrus.Logger, serverAPI grpchandler.DBService, port int) *App {
-	opts := []grpc.ServerOption{grpc.UnaryInterceptor(loggingInterceptor(log))}
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(loggingInterceptor(log), grpcstatus.UnaryServerInterceptor(errorDomain))}
 	gRPCServer := grpc.NewServer(opts...)
 	grpchandler.Register(gRPCServer, serverAPI)
 	return &App{log: log, grpcServer: gRPCServer, port: port}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"database/internal/rates"
+	dbservice "database/internal/services"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrototypeSirius/ruglogger/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// loadExchangeRates loads a CBR rates file, or every .xml file of a directory.
+// A broken file is logged and skipped so that it never blocks the start.
+func loadExchangeRates(log *logrus.Logger, db *dbservice.Database, path string) {
+	files := []string{path}
+	if info, err := os.Stat(path); err != nil {
+		logger.LogOnError(err, "Failed to open exchange rates", logrus.Fields{"path": path})
+		return
+	} else if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			logger.LogOnError(err, "Failed to read exchange rates directory", logrus.Fields{"path": path})
+			return
+		}
+		files = files[:0]
+		for _, e := range entries {
+			if !e.IsDir() && strings.EqualFold(filepath.Ext(e.Name()), ".xml") {
+				files = append(files, filepath.Join(path, e.Name()))
+			}
+		}
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			logger.LogOnError(err, "Failed to read exchange rates file", logrus.Fields{"file": file})
+			continue
+		}
+		parsed, err := rates.ParseCBR(data)
+		if err != nil {
+			logger.LogOnError(err, "Failed to parse exchange rates file", logrus.Fields{"file": file})
+			continue
+		}
+		loaded, _, err := db.SaveExchangeRates(context.Background(), parsed)
+		if err != nil {
+			logger.LogOnError(err, "Failed to save exchange rates", logrus.Fields{"file": file})
+			continue
+		}
+		log.Info(fmt.Sprintf("Loaded %d exchange rates from %s", loaded, file))
+	}
+}
@@ -12,6 +12,7 @@ type Config struct {
 	Env      string         `yaml:"env" env-default:"local"`      // local, production
 	GRPC     GRPCConfig     `yaml:"grpc"`                         // gRPC config
 	Database DatabaseConfig `yaml:"postgres" env-required:"true"` // database config
+	Rates    RatesConfig    `yaml:"rates"`                        // exchange rates config
 }
 
 type GRPCConfig struct {
@@ -28,6 +29,10 @@ type DatabaseConfig struct {
 	MigrationsPath string `yaml:"migrations_path" env-required:"true"` // migrations path
 }
 
+type RatesConfig struct {
+	Path string `yaml:"path"` // CBR XML file or directory of files loaded on startup
+}
+
 func MustLoad() *Config {
 	path := fechPathConfig()
 	if path == "" {
@@ -0,0 +1,153 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+	"regexp"
+	"strings"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const defaultCurrency = "RUB"
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func (s *serverAPI) AddAccount(ctx context.Context, req *database.AddAccountRequest) (*database.AddAccountResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1301, "Error while adding account")
+		logger.LogOnError(appErr, "Error in adding account")
+		return nil, appErr
+	}
+	a, err := interpretatorAccount(req.GetAccount())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1302, "Error while adding account")
+		logger.LogOnError(appErr, "Error in adding account")
+		return nil, appErr
+	}
+	id, mes, err := s.db.AddAccount(ctx, req.GetUserID(), a)
+	if err != nil {
+		logger.LogOnError(err, "Error in adding account")
+		return &database.AddAccountResponse{ErrorMes: mes}, err
+	}
+	return &database.AddAccountResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) EditAccount(ctx context.Context, req *database.EditAccountRequest) (*database.EditAccountResponse, error) {
+	if req.GetUserID() == 0 || req.GetAccount().GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or account id"), 1303, "Error while editing account")
+		logger.LogOnError(appErr, "Error in editing account")
+		return nil, appErr
+	}
+	a, err := interpretatorAccount(req.GetAccount())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1304, "Error while editing account")
+		logger.LogOnError(appErr, "Error in editing account")
+		return nil, appErr
+	}
+	mes, err := s.db.EditAccount(ctx, req.GetUserID(), a)
+	if err != nil {
+		logger.LogOnError(err, "Error in editing account")
+		return &database.EditAccountResponse{ErrorMes: mes}, err
+	}
+	return &database.EditAccountResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) DeleteAccount(ctx context.Context, req *database.DeleteAccountRequest) (*database.DeleteAccountResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or account id"), 1305, "Error while deleting account")
+		logger.LogOnError(appErr, "Error in deleting account")
+		return nil, appErr
+	}
+	mes, err := s.db.DeleteAccount(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		logger.LogOnError(err, "Error in deleting account")
+		return &database.DeleteAccountResponse{ErrorMes: mes}, err
+	}
+	return &database.DeleteAccountResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestUserAccounts(ctx context.Context, req *database.RequestUserAccountsRequest) (*database.RequestUserAccountsResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1306, "Error while requesting accounts")
+		logger.LogOnError(appErr, "Error in requesting accounts")
+		return nil, appErr
+	}
+	accounts, mes, err := s.db.RequestUserAccounts(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting accounts")
+		return &database.RequestUserAccountsResponse{ErrorMes: mes}, err
+	}
+	protoAccounts := make([]*database.Account, 0, len(accounts))
+	for _, a := range accounts {
+		protoAccounts = append(protoAccounts, &database.Account{
+			ID:             a.ID,
+			Name:           a.Name,
+			Currency:       a.Currency,
+			OpeningBalance: a.OpeningBalance,
+			Balance:        a.Balance,
+			IsDefault:      a.IsDefault,
+		})
+	}
+	return &database.RequestUserAccountsResponse{Accounts: protoAccounts, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) Transfer(ctx context.Context, req *database.TransferRequest) (*database.TransferResponse, error) {
+	if req.GetUserID() == 0 || req.GetTransfer() == nil {
+		appErr := apperror.BadRequestError(errors.New("empty user id or transfer"), 1307, "Error while transferring")
+		logger.LogOnError(appErr, "Error in transferring")
+		return nil, appErr
+	}
+	t := req.GetTransfer()
+	if t.GetFromAccountID() == 0 || t.GetToAccountID() == 0 || t.GetFromAccountID() == t.GetToAccountID() {
+		appErr := apperror.BadRequestError(errors.New("invalid transfer accounts"), 1308, "Error while transferring")
+		logger.LogOnError(appErr, "Error in transferring")
+		return nil, appErr
+	}
+	if t.GetAmount() <= 0 || t.GetToAmount() < 0 || t.GetDate() == 0 {
+		appErr := apperror.BadRequestError(errors.New("invalid transfer amount or date"), 1309, "Error while transferring")
+		logger.LogOnError(appErr, "Error in transferring")
+		return nil, appErr
+	}
+	res, mes, err := s.db.Transfer(ctx, req.GetUserID(), model.Transfer{
+		FromAccountID: t.GetFromAccountID(),
+		ToAccountID:   t.GetToAccountID(),
+		Amount:        t.GetAmount(),
+		ToAmount:      t.GetToAmount(),
+		Date:          t.GetDate(),
+		Description:   t.GetDescription(),
+	})
+	if err != nil {
+		logger.LogOnError(err, "Error in transferring")
+		return &database.TransferResponse{ErrorMes: mes}, err
+	}
+	return &database.TransferResponse{
+		TransferID:        res.TransferID,
+		FromTransactionID: res.FromTransactionID,
+		ToTransactionID:   res.ToTransactionID,
+		ErrorMes:          mes,
+	}, nil
+}
+
+func interpretatorAccount(a *database.Account) (model.Account, error) {
+	res := model.Account{
+		ID:             a.GetID(),
+		Name:           strings.TrimSpace(a.GetName()),
+		Currency:       strings.ToUpper(strings.TrimSpace(a.GetCurrency())),
+		OpeningBalance: a.GetOpeningBalance(),
+		IsDefault:      a.GetIsDefault(),
+	}
+	if res.Name == "" {
+		return res, errors.New("empty account name")
+	}
+	if res.Currency == "" {
+		res.Currency = defaultCurrency
+	}
+	if !currencyCode.MatchString(res.Currency) {
+		return res, errors.New("invalid currency code")
+	}
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+	"regexp"
+	"strings"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+var categoryColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *serverAPI) AddCategory(ctx context.Context, req *database.AddCategoryRequest) (*database.AddCategoryResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1318, "Error while adding category")
+		logger.LogOnError(appErr, "Error in adding category")
+		return nil, appErr
+	}
+	c, err := interpretatorCategory(req.GetCategory())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1319, "Error while adding category")
+		logger.LogOnError(appErr, "Error in adding category")
+		return nil, appErr
+	}
+	id, mes, err := s.db.AddCategory(ctx, req.GetUserID(), c)
+	if err != nil {
+		logger.LogOnError(err, "Error in adding category")
+		return &database.AddCategoryResponse{ErrorMes: mes}, err
+	}
+	return &database.AddCategoryResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) EditCategory(ctx context.Context, req *database.EditCategoryRequest) (*database.EditCategoryResponse, error) {
+	if req.GetUserID() == 0 || req.GetCategory().GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or category id"), 1320, "Error while editing category")
+		logger.LogOnError(appErr, "Error in editing category")
+		return nil, appErr
+	}
+	c, err := interpretatorCategory(req.GetCategory())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1321, "Error while editing category")
+		logger.LogOnError(appErr, "Error in editing category")
+		return nil, appErr
+	}
+	mes, err := s.db.EditCategory(ctx, req.GetUserID(), c)
+	if err != nil {
+		logger.LogOnError(err, "Error in editing category")
+		return &database.EditCategoryResponse{ErrorMes: mes}, err
+	}
+	return &database.EditCategoryResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) DeleteCategory(ctx context.Context, req *database.DeleteCategoryRequest) (*database.DeleteCategoryResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or category id"), 1322, "Error while deleting category")
+		logger.LogOnError(appErr, "Error in deleting category")
+		return nil, appErr
+	}
+	mes, err := s.db.DeleteCategory(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		logger.LogOnError(err, "Error in deleting category")
+		return &database.DeleteCategoryResponse{ErrorMes: mes}, err
+	}
+	return &database.DeleteCategoryResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestUserCategories(ctx context.Context, req *database.RequestUserCategoriesRequest) (*database.RequestUserCategoriesResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1323, "Error while requesting categories")
+		logger.LogOnError(appErr, "Error in requesting categories")
+		return nil, appErr
+	}
+	categories, mes, err := s.db.RequestUserCategories(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting categories")
+		return &database.RequestUserCategoriesResponse{ErrorMes: mes}, err
+	}
+	protoCategories := make([]*database.Category, 0, len(categories))
+	for _, c := range categories {
+		protoCategories = append(protoCategories, &database.Category{
+			ID:       c.ID,
+			Name:     c.Name,
+			Icon:     c.Icon,
+			Color:    c.Color,
+			ParentID: c.ParentID,
+			Type:     c.Type,
+		})
+	}
+	return &database.RequestUserCategoriesResponse{Categories: protoCategories, ErrorMes: mes}, nil
+}
+
+func interpretatorCategory(c *database.Category) (model.Category, error) {
+	res := model.Category{
+		ID:       c.GetID(),
+		Name:     strings.TrimSpace(c.GetName()),
+		Icon:     strings.TrimSpace(c.GetIcon()),
+		Color:    strings.TrimSpace(c.GetColor()),
+		ParentID: c.GetParentID(),
+		Type:     c.GetType(),
+	}
+	if res.Name == "" {
+		return res, errors.New("empty category name")
+	}
+	if res.Name == model.KategoriaTransfer {
+		return res, errors.New("category name is reserved for transfers")
+	}
+	if res.Type != model.TypeIncome && res.Type != model.TypeExpense {
+		return res, errors.New("invalid category type")
+	}
+	if res.Color != "" && !categoryColor.MatchString(res.Color) {
+		return res, errors.New("invalid category color")
+	}
+	if res.ParentID < 0 {
+		return res, errors.New("invalid parent category id")
+	}
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+	"time"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	defaultChatLimit = 50
+	maxChatLimit     = 200
+)
+
+var chatRoles = map[string]bool{
+	"user":      true,
+	"assistant": true,
+}
+
+func (s *serverAPI) AddChatMessages(ctx context.Context, req *database.AddChatMessagesRequest) (*database.AddChatMessagesResponse, error) {
+	if req.GetUserID() == 0 || len(req.GetMessages()) == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id or messages"), 1314, "Error while adding chat messages")
+		logger.LogOnError(appErr, "Error in adding chat messages")
+		return nil, appErr
+	}
+	now := time.Now().UnixMilli()
+	messages := make([]model.ChatMessage, 0, len(req.GetMessages()))
+	for _, m := range req.GetMessages() {
+		if !chatRoles[m.GetRole()] {
+			appErr := apperror.BadRequestError(errors.New("unknown chat role"), 1315, "Error while adding chat messages")
+			logger.LogOnError(appErr, "Error in adding chat messages")
+			return nil, appErr
+		}
+		createdAt := m.GetCreatedAt()
+		if createdAt == 0 {
+			createdAt = now
+		}
+		messages = append(messages, model.ChatMessage{Role: m.GetRole(), Content: m.GetContent(), CreatedAt: createdAt})
+	}
+	mes, err := s.db.AddChatMessages(ctx, req.GetUserID(), messages)
+	if err != nil {
+		logger.LogOnError(err, "Error in adding chat messages")
+		return &database.AddChatMessagesResponse{ErrorMes: mes}, err
+	}
+	return &database.AddChatMessagesResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestChatMessages(ctx context.Context, req *database.RequestChatMessagesRequest) (*database.RequestChatMessagesResponse, error) {
+	if req.GetUserID() == 0 || req.GetLimit() < 0 || req.GetBefore() < 0 {
+		appErr := apperror.BadRequestError(errors.New("invalid chat messages request"), 1316, "Error while requesting chat messages")
+		logger.LogOnError(appErr, "Error in requesting chat messages")
+		return nil, appErr
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultChatLimit
+	}
+	limit = min(limit, maxChatLimit)
+	messages, mes, err := s.db.RequestChatMessages(ctx, req.GetUserID(), limit, req.GetBefore())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting chat messages")
+		return &database.RequestChatMessagesResponse{ErrorMes: mes}, err
+	}
+	protoMessages := make([]*database.ChatMessage, 0, len(messages))
+	for _, m := range messages {
+		protoMessages = append(protoMessages, &database.ChatMessage{
+			ID:        m.ID,
+			Role:      m.Role,
+			Content:   m.Content,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return &database.RequestChatMessagesResponse{Messages: protoMessages, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) ClearChatMessages(ctx context.Context, req *database.ClearChatMessagesRequest) (*database.ClearChatMessagesResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1317, "Error while clearing chat messages")
+		logger.LogOnError(appErr, "Error in clearing chat messages")
+		return nil, appErr
+	}
+	mes, err := s.db.ClearChatMessages(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in clearing chat messages")
+		return &database.ClearChatMessagesResponse{ErrorMes: mes}, err
+	}
+	return &database.ClearChatMessagesResponse{ErrorMes: mes}, nil
+}
@@ -0,0 +1,47 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	defaultCorrectionsLimit = 100
+	maxCorrectionsLimit     = 1000
+)
+
+func (s *serverAPI) RequestCategoryCorrections(ctx context.Context, req *database.RequestCategoryCorrectionsRequest) (*database.RequestCategoryCorrectionsResponse, error) {
+	if req.GetUserID() == 0 || req.GetLimit() < 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id or negative limit"), 1330, "Error while requesting category corrections")
+		logger.LogOnError(appErr, "Error in requesting category corrections")
+		return nil, appErr
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultCorrectionsLimit
+	}
+	limit = min(limit, maxCorrectionsLimit)
+	corrections, mes, err := s.db.RequestCategoryCorrections(ctx, req.GetUserID(), limit)
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting category corrections")
+		return &database.RequestCategoryCorrectionsResponse{ErrorMes: mes}, err
+	}
+	protoCorrections := make([]*database.CategoryCorrection, 0, len(corrections))
+	for _, c := range corrections {
+		protoCorrections = append(protoCorrections, &database.CategoryCorrection{
+			ID:            c.ID,
+			TransactionID: c.TransactionID,
+			Description:   c.Description,
+			Type:          c.Type,
+			Amount:        c.Amount,
+			OldKategoria:  c.OldKategoria,
+			NewKategoria:  c.NewKategoria,
+			CreatedAt:     c.CreatedAt,
+		})
+	}
+	return &database.RequestCategoryCorrectionsResponse{Corrections: protoCorrections, ErrorMes: mes}, nil
+}
@@ -0,0 +1,75 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const maxClaimedJobs = 100
+
+func (s *serverAPI) ClaimCategorizationJobs(ctx context.Context, req *database.ClaimCategorizationJobsRequest) (*database.ClaimCategorizationJobsResponse, error) {
+	if req.GetLimit() <= 0 || req.GetLeaseUntil() <= req.GetNow() {
+		appErr := apperror.BadRequestError(errors.New("non-positive limit or lease"), 1331, "Error while claiming categorization jobs")
+		logger.LogOnError(appErr, "Error in claiming categorization jobs")
+		return nil, appErr
+	}
+	jobs, mes, err := s.db.ClaimCategorizationJobs(ctx, req.GetNow(), req.GetLeaseUntil(), min(req.GetLimit(), maxClaimedJobs))
+	if err != nil {
+		logger.LogOnError(err, "Error in claiming categorization jobs")
+		return &database.ClaimCategorizationJobsResponse{ErrorMes: mes}, err
+	}
+	protoJobs := make([]*database.CategorizationJob, 0, len(jobs))
+	for _, j := range jobs {
+		t := j.Transaction
+		protoJobs = append(protoJobs, &database.CategorizationJob{
+			ID:            j.ID,
+			UserID:        j.UserID,
+			TransactionID: j.TransactionID,
+			Attempts:      j.Attempts,
+			Transaction: &database.Transaction{
+				ID:              t.ID,
+				Date:            t.Date,
+				Kategoria:       t.Kategoria,
+				Type:            t.Type,
+				Amount:          t.Amount,
+				Description:     t.Description,
+				AccountID:       t.AccountID,
+				Currency:        t.Currency,
+				CategoryPending: t.CategoryPending,
+			},
+		})
+	}
+	return &database.ClaimCategorizationJobsResponse{Jobs: protoJobs, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) CompleteCategorizationJob(ctx context.Context, req *database.CompleteCategorizationJobRequest) (*database.CompleteCategorizationJobResponse, error) {
+	if req.GetID() == 0 || req.GetAttempts() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty job id or attempts"), 1332, "Error while completing categorization job")
+		logger.LogOnError(appErr, "Error in completing categorization job")
+		return nil, appErr
+	}
+	applied, mes, err := s.db.CompleteCategorizationJob(ctx, req.GetID(), req.GetAttempts(), req.GetKategoria())
+	if err != nil {
+		logger.LogOnError(err, "Error in completing categorization job")
+		return &database.CompleteCategorizationJobResponse{ErrorMes: mes}, err
+	}
+	return &database.CompleteCategorizationJobResponse{Applied: applied, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) FailCategorizationJob(ctx context.Context, req *database.FailCategorizationJobRequest) (*database.FailCategorizationJobResponse, error) {
+	if req.GetID() == 0 || req.GetAttempts() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty job id or attempts"), 1333, "Error while failing categorization job")
+		logger.LogOnError(appErr, "Error in failing categorization job")
+		return nil, appErr
+	}
+	mes, err := s.db.FailCategorizationJob(ctx, req.GetID(), req.GetAttempts(), req.GetError(), req.GetRetryAt(), req.GetFinal())
+	if err != nil {
+		logger.LogOnError(err, "Error in failing categorization job")
+		return &database.FailCategorizationJobResponse{ErrorMes: mes}, err
+	}
+	return &database.FailCategorizationJobResponse{ErrorMes: mes}, nil
+}
@@ -0,0 +1,56 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+func (s *serverAPI) RequestRateLimit(ctx context.Context, req *database.RequestRateLimitRequest) (*database.RequestRateLimitResponse, error) {
+	if req.GetUserID() == 0 || req.GetKey() == "" {
+		appErr := apperror.BadRequestError(errors.New("empty user id or key"), 1334, "Error while requesting rate limit")
+		logger.LogOnError(appErr, "Error in requesting rate limit")
+		return nil, appErr
+	}
+	state, mes, err := s.db.RequestRateLimit(ctx, req.GetUserID(), req.GetKey())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting rate limit")
+		return &database.RequestRateLimitResponse{ErrorMes: mes}, err
+	}
+	return &database.RequestRateLimitResponse{
+		State: &database.RateLimitState{
+			Tokens:    state.Tokens,
+			UpdatedAt: state.UpdatedAt,
+			Day:       state.Day,
+			Used:      state.Used,
+			Version:   state.Version,
+		},
+		ErrorMes: mes,
+	}, nil
+}
+
+func (s *serverAPI) SwapRateLimit(ctx context.Context, req *database.SwapRateLimitRequest) (*database.SwapRateLimitResponse, error) {
+	if req.GetUserID() == 0 || req.GetKey() == "" || req.GetState() == nil {
+		appErr := apperror.BadRequestError(errors.New("empty user id, key or state"), 1335, "Error while updating rate limit")
+		logger.LogOnError(appErr, "Error in updating rate limit")
+		return nil, appErr
+	}
+	st := req.GetState()
+	state := model.RateLimitState{
+		Tokens:    st.GetTokens(),
+		UpdatedAt: st.GetUpdatedAt(),
+		Day:       st.GetDay(),
+		Used:      st.GetUsed(),
+		Version:   st.GetVersion(),
+	}
+	swapped, mes, err := s.db.SwapRateLimit(ctx, req.GetUserID(), req.GetKey(), state)
+	if err != nil {
+		logger.LogOnError(err, "Error in updating rate limit")
+		return &database.SwapRateLimitResponse{ErrorMes: mes}, err
+	}
+	return &database.SwapRateLimitResponse{Swapped: swapped, ErrorMes: mes}, nil
+}
@@ -0,0 +1,31 @@
+package grpchandler
+
+import (
+	"context"
+	"database/internal/rates"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+func (s *serverAPI) LoadExchangeRates(ctx context.Context, req *database.LoadExchangeRatesRequest) (*database.LoadExchangeRatesResponse, error) {
+	if len(req.GetData()) == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty rates file"), 1312, "Error while loading exchange rates")
+		logger.LogOnError(appErr, "Error in loading exchange rates")
+		return nil, appErr
+	}
+	parsed, err := rates.ParseCBR(req.GetData())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1313, "Error while loading exchange rates")
+		logger.LogOnError(appErr, "Error in loading exchange rates")
+		return nil, appErr
+	}
+	loaded, mes, err := s.db.SaveExchangeRates(ctx, parsed)
+	if err != nil {
+		logger.LogOnError(err, "Error in loading exchange rates")
+		return &database.LoadExchangeRatesResponse{ErrorMes: mes}, err
+	}
+	return &database.LoadExchangeRatesResponse{Loaded: loaded, ErrorMes: mes}, nil
+}
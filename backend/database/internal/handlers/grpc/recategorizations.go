@@ -0,0 +1,56 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"encoding/json"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+func (s *serverAPI) StartRecategorization(ctx context.Context, req *database.StartRecategorizationRequest) (*database.StartRecategorizationResponse, error) {
+	if req.GetUserID() == 0 || req.GetStatus() == "" || !json.Valid(req.GetData()) {
+		appErr := apperror.BadRequestError(errors.New("empty user id or status, or invalid data"), 1337, "Error while starting recategorization")
+		logger.LogOnError(appErr, "Error in starting recategorization")
+		return nil, appErr
+	}
+	r := model.Recategorization{Status: req.GetStatus(), Data: req.GetData()}
+	id, started, mes, err := s.db.StartRecategorization(ctx, req.GetUserID(), r, req.GetNow(), req.GetStaleBefore())
+	if err != nil {
+		logger.LogOnError(err, "Error in starting recategorization")
+		return &database.StartRecategorizationResponse{ErrorMes: mes}, err
+	}
+	return &database.StartRecategorizationResponse{ID: id, Started: started, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) UpdateRecategorization(ctx context.Context, req *database.UpdateRecategorizationRequest) (*database.UpdateRecategorizationResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 || req.GetStatus() == "" || !json.Valid(req.GetData()) {
+		appErr := apperror.BadRequestError(errors.New("empty user id, id or status, or invalid data"), 1338, "Error while updating recategorization")
+		logger.LogOnError(appErr, "Error in updating recategorization")
+		return nil, appErr
+	}
+	r := model.Recategorization{ID: req.GetID(), Status: req.GetStatus(), Data: req.GetData()}
+	updated, mes, err := s.db.UpdateRecategorization(ctx, req.GetUserID(), r, req.GetFromStatus(), req.GetNow())
+	if err != nil {
+		logger.LogOnError(err, "Error in updating recategorization")
+		return &database.UpdateRecategorizationResponse{ErrorMes: mes}, err
+	}
+	return &database.UpdateRecategorizationResponse{Updated: updated, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestRecategorization(ctx context.Context, req *database.RequestRecategorizationRequest) (*database.RequestRecategorizationResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1339, "Error while requesting recategorization")
+		logger.LogOnError(appErr, "Error in requesting recategorization")
+		return nil, appErr
+	}
+	r, found, mes, err := s.db.RequestRecategorization(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting recategorization")
+		return &database.RequestRecategorizationResponse{ErrorMes: mes}, err
+	}
+	return &database.RequestRecategorizationResponse{ID: r.ID, Status: r.Status, Data: r.Data, Found: found, ErrorMes: mes}, nil
+}
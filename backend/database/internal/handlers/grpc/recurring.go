@@ -0,0 +1,170 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+var recurringFrequencies = map[string]bool{
+	"daily":   true,
+	"weekly":  true,
+	"monthly": true,
+	"yearly":  true,
+}
+
+func (s *serverAPI) AddRecurring(ctx context.Context, req *database.AddRecurringRequest) (*database.AddRecurringResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1067, "Error while adding recurring transaction")
+		logger.LogOnError(appErr, "Error in adding recurring transaction")
+		return nil, appErr
+	}
+	r := req.GetRecurring()
+	if r == nil {
+		appErr := apperror.BadRequestError(errors.New("empty recurring transaction"), 1068, "Error while adding recurring transaction")
+		logger.LogOnError(appErr, "Error in adding recurring transaction")
+		return nil, appErr
+	}
+	if !recurringFrequencies[r.GetFrequency()] {
+		appErr := apperror.BadRequestError(errors.New("unknown frequency"), 1069, "Error while adding recurring transaction")
+		logger.LogOnError(appErr, "Error in adding recurring transaction")
+		return nil, appErr
+	}
+	if r.GetStartDate() == 0 || (r.GetEndDate() != 0 && r.GetEndDate() < r.GetStartDate()) {
+		appErr := apperror.BadRequestError(errors.New("invalid recurring period"), 1070, "Error while adding recurring transaction")
+		logger.LogOnError(appErr, "Error in adding recurring transaction")
+		return nil, appErr
+	}
+	id, mes, err := s.db.AddRecurring(ctx, req.GetUserID(), interpretatorRecurring(r))
+	if err != nil {
+		logger.LogOnError(err, "Error in adding recurring transaction")
+		return &database.AddRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.AddRecurringResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestUserRecurring(ctx context.Context, req *database.RequestUserRecurringRequest) (*database.RequestUserRecurringResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1071, "Error while requesting recurring transactions")
+		logger.LogOnError(appErr, "Error in requesting recurring transactions")
+		return nil, appErr
+	}
+	recurring, mes, err := s.db.RequestUserRecurring(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting recurring transactions")
+		return &database.RequestUserRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.RequestUserRecurringResponse{Recurring: interpretatorRecurringResponse(recurring), ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) SetRecurringPaused(ctx context.Context, req *database.SetRecurringPausedRequest) (*database.SetRecurringPausedResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or recurring id"), 1072, "Error while pausing recurring transaction")
+		logger.LogOnError(appErr, "Error in pausing recurring transaction")
+		return nil, appErr
+	}
+	mes, err := s.db.SetRecurringPaused(ctx, req.GetUserID(), req.GetID(), req.GetPaused())
+	if err != nil {
+		logger.LogOnError(err, "Error in pausing recurring transaction")
+		return &database.SetRecurringPausedResponse{ErrorMes: mes}, err
+	}
+	return &database.SetRecurringPausedResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) DeleteRecurring(ctx context.Context, req *database.DeleteRecurringRequest) (*database.DeleteRecurringResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or recurring id"), 1073, "Error while deleting recurring transaction")
+		logger.LogOnError(appErr, "Error in deleting recurring transaction")
+		return nil, appErr
+	}
+	mes, err := s.db.DeleteRecurring(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		logger.LogOnError(err, "Error in deleting recurring transaction")
+		return &database.DeleteRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.DeleteRecurringResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestDueRecurring(ctx context.Context, req *database.RequestDueRecurringRequest) (*database.RequestDueRecurringResponse, error) {
+	if req.GetBefore() == 0 || req.GetLimit() <= 0 {
+		appErr := apperror.BadRequestError(errors.New("empty due date or limit"), 1074, "Error while requesting due recurring transactions")
+		logger.LogOnError(appErr, "Error in requesting due recurring transactions")
+		return nil, appErr
+	}
+	recurring, mes, err := s.db.RequestDueRecurring(ctx, req.GetBefore(), req.GetLimit())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting due recurring transactions")
+		return &database.RequestDueRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.RequestDueRecurringResponse{Recurring: interpretatorRecurringResponse(recurring), ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) AdvanceRecurring(ctx context.Context, req *database.AdvanceRecurringRequest) (*database.AdvanceRecurringResponse, error) {
+	if req.GetID() == 0 || req.GetNextRuns() <= req.GetRuns() {
+		appErr := apperror.BadRequestError(errors.New("invalid recurring advance"), 1075, "Error while advancing recurring transaction")
+		logger.LogOnError(appErr, "Error in advancing recurring transaction")
+		return nil, appErr
+	}
+	claimed, mes, err := s.db.AdvanceRecurring(ctx, req.GetID(), req.GetRuns(), req.GetNextRuns(), req.GetNextDate())
+	if err != nil {
+		logger.LogOnError(err, "Error in advancing recurring transaction")
+		return &database.AdvanceRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.AdvanceRecurringResponse{Claimed: claimed, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) MaterializeRecurring(ctx context.Context, req *database.MaterializeRecurringRequest) (*database.MaterializeRecurringResponse, error) {
+	if req.GetID() == 0 || req.GetUserID() == 0 || req.GetTransaction() == nil {
+		appErr := apperror.BadRequestError(errors.New("empty recurring id, user id or transaction"), 1336, "Error while materializing recurring transaction")
+		logger.LogOnError(appErr, "Error in materializing recurring transaction")
+		return nil, appErr
+	}
+	id, claimed, mes, err := s.db.MaterializeRecurring(ctx, req.GetUserID(), req.GetID(), req.GetRuns(), req.GetNextDate(), interpretatorTransaction(req.GetTransaction()))
+	if err != nil {
+		logger.LogOnError(err, "Error in materializing recurring transaction")
+		return &database.MaterializeRecurringResponse{ErrorMes: mes}, err
+	}
+	return &database.MaterializeRecurringResponse{Claimed: claimed, TransactionID: id, ErrorMes: mes}, nil
+}
+
+func interpretatorRecurring(r *database.Recurring) model.Recurring {
+	return model.Recurring{
+		ID:          r.GetID(),
+		UserID:      r.GetUserID(),
+		Kategoria:   r.GetKategoria(),
+		Type:        r.GetType(),
+		Amount:      r.GetAmount(),
+		Description: r.GetDescription(),
+		Frequency:   r.GetFrequency(),
+		StartDate:   r.GetStartDate(),
+		EndDate:     r.GetEndDate(),
+		NextDate:    r.GetNextDate(),
+		Runs:        r.GetRuns(),
+		Paused:      r.GetPaused(),
+	}
+}
+
+func interpretatorRecurringResponse(recurring []model.Recurring) []*database.Recurring {
+	protoRecurring := make([]*database.Recurring, 0, len(recurring))
+	for _, r := range recurring {
+		protoRecurring = append(protoRecurring, &database.Recurring{
+			ID:          r.ID,
+			UserID:      r.UserID,
+			Kategoria:   r.Kategoria,
+			Type:        r.Type,
+			Amount:      r.Amount,
+			Description: r.Description,
+			Frequency:   r.Frequency,
+			StartDate:   r.StartDate,
+			EndDate:     r.EndDate,
+			NextDate:    r.NextDate,
+			Runs:        r.Runs,
+			Paused:      r.Paused,
+		})
+	}
+	return protoRecurring
+}
@@ -0,0 +1,126 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+	"regexp"
+	"strings"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+func (s *serverAPI) AddRule(ctx context.Context, req *database.AddRuleRequest) (*database.AddRuleResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1324, "Error while adding rule")
+		logger.LogOnError(appErr, "Error in adding rule")
+		return nil, appErr
+	}
+	r, err := interpretatorRule(req.GetRule())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1325, "Error while adding rule")
+		logger.LogOnError(appErr, "Error in adding rule")
+		return nil, appErr
+	}
+	id, mes, err := s.db.AddRule(ctx, req.GetUserID(), r)
+	if err != nil {
+		logger.LogOnError(err, "Error in adding rule")
+		return &database.AddRuleResponse{ErrorMes: mes}, err
+	}
+	return &database.AddRuleResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) EditRule(ctx context.Context, req *database.EditRuleRequest) (*database.EditRuleResponse, error) {
+	if req.GetUserID() == 0 || req.GetRule().GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or rule id"), 1326, "Error while editing rule")
+		logger.LogOnError(appErr, "Error in editing rule")
+		return nil, appErr
+	}
+	r, err := interpretatorRule(req.GetRule())
+	if err != nil {
+		appErr := apperror.BadRequestError(err, 1327, "Error while editing rule")
+		logger.LogOnError(appErr, "Error in editing rule")
+		return nil, appErr
+	}
+	mes, err := s.db.EditRule(ctx, req.GetUserID(), r)
+	if err != nil {
+		logger.LogOnError(err, "Error in editing rule")
+		return &database.EditRuleResponse{ErrorMes: mes}, err
+	}
+	return &database.EditRuleResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) DeleteRule(ctx context.Context, req *database.DeleteRuleRequest) (*database.DeleteRuleResponse, error) {
+	if req.GetUserID() == 0 || req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user or rule id"), 1328, "Error while deleting rule")
+		logger.LogOnError(appErr, "Error in deleting rule")
+		return nil, appErr
+	}
+	mes, err := s.db.DeleteRule(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		logger.LogOnError(err, "Error in deleting rule")
+		return &database.DeleteRuleResponse{ErrorMes: mes}, err
+	}
+	return &database.DeleteRuleResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestUserRules(ctx context.Context, req *database.RequestUserRulesRequest) (*database.RequestUserRulesResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1329, "Error while requesting rules")
+		logger.LogOnError(appErr, "Error in requesting rules")
+		return nil, appErr
+	}
+	rules, mes, err := s.db.RequestUserRules(ctx, req.GetUserID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting rules")
+		return &database.RequestUserRulesResponse{ErrorMes: mes}, err
+	}
+	protoRules := make([]*database.Rule, 0, len(rules))
+	for _, r := range rules {
+		protoRules = append(protoRules, &database.Rule{
+			ID:                  r.ID,
+			Priority:            r.Priority,
+			DescriptionContains: r.DescriptionContains,
+			DescriptionRegex:    r.DescriptionRegex,
+			AmountMin:           r.AmountMin,
+			AmountMax:           r.AmountMax,
+			Type:                r.Type,
+			Kategoria:           r.Kategoria,
+			Global:              r.Global,
+		})
+	}
+	return &database.RequestUserRulesResponse{Rules: protoRules, ErrorMes: mes}, nil
+}
+
+func interpretatorRule(r *database.Rule) (model.Rule, error) {
+	res := model.Rule{
+		ID:                  r.GetID(),
+		Priority:            r.GetPriority(),
+		DescriptionContains: strings.TrimSpace(r.GetDescriptionContains()),
+		DescriptionRegex:    strings.TrimSpace(r.GetDescriptionRegex()),
+		AmountMin:           r.GetAmountMin(),
+		AmountMax:           r.GetAmountMax(),
+		Type:                r.GetType(),
+		Kategoria:           strings.TrimSpace(r.GetKategoria()),
+	}
+	if res.Kategoria == "" {
+		return res, errors.New("empty rule category")
+	}
+	if res.Type != "" && res.Type != model.TypeIncome && res.Type != model.TypeExpense {
+		return res, errors.New("invalid rule type")
+	}
+	if res.AmountMin < 0 || res.AmountMax < 0 || (res.AmountMax != 0 && res.AmountMax < res.AmountMin) {
+		return res, errors.New("invalid rule amount range")
+	}
+	if res.DescriptionRegex != "" {
+		if _, err := regexp.Compile(res.DescriptionRegex); err != nil {
+			return res, err
+		}
+	}
+	if res.DescriptionContains == "" && res.DescriptionRegex == "" && res.AmountMin == 0 && res.AmountMax == 0 && res.Type == "" {
+		return res, errors.New("rule has no conditions")
+	}
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	model "database/internal/models"
 	"errors"
+	"strings"
 
 	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
 	"github.com/PrototypeSirius/ruglogger/apperror"
@@ -12,12 +13,51 @@ import (
 )
 
 type DBService interface {
-	AddTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error)
+	AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, string, error)
+	AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, string, error)
 	AddUser(ctx context.Context, uid int64) (string, error)
 	DeleteTransaction(ctx context.Context, uid, tid int64) (string, error)
 	DeleteUser(ctx context.Context, uid int64) (string, error)
-	EditTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error)
-	RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, string, error)
+	EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, string, error)
+	RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, string, error)
+	RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, string, error)
+	SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, string, error)
+	DeleteBudget(ctx context.Context, uid, bid int64) (string, error)
+	RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, string, error)
+	AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, string, error)
+	RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, string, error)
+	SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) (string, error)
+	DeleteRecurring(ctx context.Context, uid, rid int64) (string, error)
+	RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, string, error)
+	AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, string, error)
+	MaterializeRecurring(ctx context.Context, uid, rid, runs, nextDate int64, t model.Transaction) (int64, bool, string, error)
+	RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, string, error)
+	AddAccount(ctx context.Context, uid int64, a model.Account) (int64, string, error)
+	EditAccount(ctx context.Context, uid int64, a model.Account) (string, error)
+	DeleteAccount(ctx context.Context, uid, aid int64) (string, error)
+	RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, string, error)
+	Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, string, error)
+	SaveExchangeRates(ctx context.Context, rates []model.ExchangeRate) (int64, string, error)
+	AddChatMessages(ctx context.Context, uid int64, messages []model.ChatMessage) (string, error)
+	RequestChatMessages(ctx context.Context, uid, limit, before int64) ([]model.ChatMessage, string, error)
+	ClearChatMessages(ctx context.Context, uid int64) (string, error)
+	AddCategory(ctx context.Context, uid int64, c model.Category) (int64, string, error)
+	EditCategory(ctx context.Context, uid int64, c model.Category) (string, error)
+	DeleteCategory(ctx context.Context, uid, cid int64) (string, error)
+	RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, string, error)
+	AddRule(ctx context.Context, uid int64, r model.Rule) (int64, string, error)
+	EditRule(ctx context.Context, uid int64, r model.Rule) (string, error)
+	DeleteRule(ctx context.Context, uid, rid int64) (string, error)
+	RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, string, error)
+	RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, string, error)
+	ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, string, error)
+	CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, string, error)
+	FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) (string, error)
+	RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, string, error)
+	SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, string, error)
+	StartRecategorization(ctx context.Context, uid int64, r model.Recategorization, now, staleBefore int64) (int64, bool, string, error)
+	UpdateRecategorization(ctx context.Context, uid int64, r model.Recategorization, fromStatus string, now int64) (bool, string, error)
+	RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, string, error)
 }
 
 type serverAPI struct {
@@ -40,12 +80,35 @@ func (s *serverAPI) AddTransaction(ctx context.Context, req *database.AddTransac
 		logger.LogOnError(appErr, "Error in adding transaction")
 		return nil, appErr
 	}
-	mes, err := s.db.AddTransaction(ctx, req.GetUserID(), interpretatorTransactionAdd(req))
+	id, mes, err := s.db.AddTransaction(ctx, req.GetUserID(), interpretatorTransactionAdd(req), req.GetCategorize())
 	if err != nil {
 		logger.LogOnError(err, "Error in adding transaction")
 		return &database.AddTransactionResponse{ErrorMes: mes}, err
 	}
-	return &database.AddTransactionResponse{ErrorMes: mes}, nil
+	return &database.AddTransactionResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) AddTransactions(ctx context.Context, req *database.AddTransactionsRequest) (*database.AddTransactionsResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1076, "Error while adding transactions")
+		logger.LogOnError(appErr, "Error in adding transactions")
+		return nil, appErr
+	}
+	if len(req.GetTransactions()) == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty transactions"), 1077, "Error while adding transactions")
+		logger.LogOnError(appErr, "Error in adding transactions")
+		return nil, appErr
+	}
+	transactions := make([]model.Transaction, 0, len(req.GetTransactions()))
+	for _, t := range req.GetTransactions() {
+		transactions = append(transactions, interpretatorTransaction(t))
+	}
+	ids, mes, err := s.db.AddTransactions(ctx, req.GetUserID(), transactions, req.GetSkipDuplicates())
+	if err != nil {
+		logger.LogOnError(err, "Error in adding transactions")
+		return &database.AddTransactionsResponse{ErrorMes: mes}, err
+	}
+	return &database.AddTransactionsResponse{IDs: ids, ErrorMes: mes}, nil
 }
 
 func (s *serverAPI) AddUser(ctx context.Context, req *database.AddUserRequest) (*database.AddUserResponse, error) {
@@ -106,12 +169,21 @@ func (s *serverAPI) EditTransaction(ctx context.Context, req *database.EditTrans
 		logger.LogOnError(appErr, "Error in editing transaction")
 		return nil, appErr
 	}
-	mes, err := s.db.EditTransaction(ctx, req.GetUserID(), interpretatorTransactionEdit(req))
+	if req.GetRecategorize() {
+		t := req.GetTransaction()
+		applied, mes, err := s.db.RecategorizeTransaction(ctx, req.GetUserID(), t.GetID(), req.GetPreviousKategoria(), t.GetKategoria())
+		if err != nil {
+			logger.LogOnError(err, "Error in recategorizing transaction")
+			return &database.EditTransactionResponse{ErrorMes: mes}, err
+		}
+		return &database.EditTransactionResponse{Applied: applied, ErrorMes: mes}, nil
+	}
+	corrected, mes, err := s.db.EditTransaction(ctx, req.GetUserID(), interpretatorTransactionEdit(req))
 	if err != nil {
 		logger.LogOnError(err, "Error in editing transaction")
 		return &database.EditTransactionResponse{ErrorMes: mes}, err
 	}
-	return &database.EditTransactionResponse{}, nil
+	return &database.EditTransactionResponse{CategoryCorrected: corrected, Applied: true}, nil
 }
 
 func (s *serverAPI) RequestUserTransactions(ctx context.Context, req *database.RequestUserTransactionsRequest) (*database.RequestUserTransactionsResponse, error) {
@@ -120,16 +192,102 @@ func (s *serverAPI) RequestUserTransactions(ctx context.Context, req *database.R
 		logger.LogOnError(appErr, "Error in requesting user transactions")
 		return nil, appErr
 	}
-	transactions, mes, err := s.db.RequestUserTransactions(ctx, req.GetUserID())
+	if req.GetLimit() < 0 {
+		appErr := apperror.BadRequestError(errors.New("negative limit"), 1059, "Error while requesting user transactions")
+		logger.LogOnError(appErr, "Error in requesting user transactions")
+		return nil, appErr
+	}
+	if req.GetBaseCurrency() != "" && !currencyCode.MatchString(req.GetBaseCurrency()) {
+		appErr := apperror.BadRequestError(errors.New("invalid base currency"), 1310, "Error while requesting user transactions")
+		logger.LogOnError(appErr, "Error in requesting user transactions")
+		return nil, appErr
+	}
+	page, mes, err := s.db.RequestUserTransactions(ctx, req.GetUserID(), interpretatorTransactionQuery(req))
 	if err != nil {
 		logger.LogOnError(err, "Error in requesting user transactions")
 		return &database.RequestUserTransactionsResponse{ErrorMes: mes}, err
 	}
-	return interpretatorTransactionResponse(transactions), nil
+	return interpretatorTransactionResponse(page), nil
+}
+
+func (s *serverAPI) SetBudget(ctx context.Context, req *database.SetBudgetRequest) (*database.SetBudgetResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1060, "Error while setting budget")
+		logger.LogOnError(appErr, "Error in setting budget")
+		return nil, appErr
+	}
+	b := req.GetBudget()
+	if b.GetKategoria() == "" {
+		appErr := apperror.BadRequestError(errors.New("empty budget category"), 1061, "Error while setting budget")
+		logger.LogOnError(appErr, "Error in setting budget")
+		return nil, appErr
+	}
+	if b.GetLimit() <= 0 {
+		appErr := apperror.BadRequestError(errors.New("non-positive budget limit"), 1062, "Error while setting budget")
+		logger.LogOnError(appErr, "Error in setting budget")
+		return nil, appErr
+	}
+	id, mes, err := s.db.SetBudget(ctx, req.GetUserID(), model.Budget{ID: b.GetID(), Kategoria: b.GetKategoria(), Limit: b.GetLimit()})
+	if err != nil {
+		logger.LogOnError(err, "Error in setting budget")
+		return &database.SetBudgetResponse{ErrorMes: mes}, err
+	}
+	return &database.SetBudgetResponse{ID: id, ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) DeleteBudget(ctx context.Context, req *database.DeleteBudgetRequest) (*database.DeleteBudgetResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1063, "Error while deleting budget")
+		logger.LogOnError(appErr, "Error in deleting budget")
+		return nil, appErr
+	}
+	if req.GetID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty budget id"), 1064, "Error while deleting budget")
+		logger.LogOnError(appErr, "Error in deleting budget")
+		return nil, appErr
+	}
+	mes, err := s.db.DeleteBudget(ctx, req.GetUserID(), req.GetID())
+	if err != nil {
+		logger.LogOnError(err, "Error in deleting budget")
+		return &database.DeleteBudgetResponse{ErrorMes: mes}, err
+	}
+	return &database.DeleteBudgetResponse{ErrorMes: mes}, nil
+}
+
+func (s *serverAPI) RequestUserBudgets(ctx context.Context, req *database.RequestUserBudgetsRequest) (*database.RequestUserBudgetsResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1065, "Error while requesting user budgets")
+		logger.LogOnError(appErr, "Error in requesting user budgets")
+		return nil, appErr
+	}
+	if req.GetPeriodEnd() <= req.GetPeriodStart() {
+		appErr := apperror.BadRequestError(errors.New("empty budget period"), 1066, "Error while requesting user budgets")
+		logger.LogOnError(appErr, "Error in requesting user budgets")
+		return nil, appErr
+	}
+	budgets, mes, err := s.db.RequestUserBudgets(ctx, req.GetUserID(), req.GetPeriodStart(), req.GetPeriodEnd(), req.GetKategorias(), req.GetTransactionID())
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting user budgets")
+		return &database.RequestUserBudgetsResponse{ErrorMes: mes}, err
+	}
+	protoBudgets := make([]*database.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		protoBudgets = append(protoBudgets, &database.Budget{
+			ID:        b.ID,
+			Kategoria: b.Kategoria,
+			Limit:     b.Limit,
+			Spent:     b.Spent,
+			Added:     b.Added,
+		})
+	}
+	return &database.RequestUserBudgetsResponse{Budgets: protoBudgets, ErrorMes: mes}, nil
 }
 
 func interpretatorTransactionAdd(req *database.AddTransactionRequest) model.Transaction {
-	t := req.GetTransaction()
+	return interpretatorTransaction(req.GetTransaction())
+}
+
+func interpretatorTransaction(t *database.Transaction) model.Transaction {
 	return model.Transaction{
 		ID:          t.ID,
 		Date:        t.Date,
@@ -137,6 +295,8 @@ func interpretatorTransactionAdd(req *database.AddTransactionRequest) model.Tran
 		Type:        t.Type,
 		Amount:      t.Amount,
 		Description: t.Description,
+		AccountID:   t.AccountID,
+		Currency:    strings.ToUpper(t.Currency),
 	}
 }
 
@@ -149,22 +309,53 @@ func interpretatorTransactionEdit(req *database.EditTransactionRequest) model.Tr
 		Type:        t.Type,
 		Amount:      t.Amount,
 		Description: t.Description,
+		AccountID:   t.AccountID,
+		Currency:    strings.ToUpper(t.Currency),
+	}
+}
+
+func interpretatorTransactionQuery(req *database.RequestUserTransactionsRequest) model.TransactionQuery {
+	f := req.GetFilter()
+	q := model.TransactionQuery{
+		DateFrom:     f.GetDateFrom(),
+		DateTo:       f.GetDateTo(),
+		Kategorias:   f.GetKategorias(),
+		Type:         f.GetType(),
+		Description:  f.GetDescription(),
+		AccountID:    f.GetAccountID(),
+		SortBy:       req.GetSortBy(),
+		Cursor:       req.GetCursor(),
+		Limit:        req.GetLimit(),
+		BaseCurrency: req.GetBaseCurrency(),
+		RateDate:     req.GetRateDate(),
+	}
+	if f != nil {
+		q.AmountMin = f.AmountMin
+		q.AmountMax = f.AmountMax
 	}
+	return q
 }
 
-func interpretatorTransactionResponse(transactions []model.Transaction) *database.RequestUserTransactionsResponse {
+func interpretatorTransactionResponse(page model.TransactionPage) *database.RequestUserTransactionsResponse {
 	var protoTransactions []*database.Transaction
-	for _, t := range transactions {
+	for _, t := range page.Transactions {
 		protoTransactions = append(protoTransactions, &database.Transaction{
-			ID:          t.ID,
-			Date:        t.Date,
-			Kategoria:   t.Kategoria,
-			Type:        t.Type,
-			Amount:      t.Amount,
-			Description: t.Description,
+			ID:              t.ID,
+			Date:            t.Date,
+			Kategoria:       t.Kategoria,
+			Type:            t.Type,
+			Amount:          t.Amount,
+			Description:     t.Description,
+			AccountID:       t.AccountID,
+			TransferID:      t.TransferID,
+			Currency:        t.Currency,
+			BaseAmount:      t.BaseAmount,
+			CategoryPending: t.CategoryPending,
 		})
 	}
 	return &database.RequestUserTransactionsResponse{
 		Transactions: protoTransactions,
+		NextCursor:   page.NextCursor,
+		Total:        page.Total,
 	}
 }
@@ -0,0 +1,118 @@
+package grpchandler
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	defaultStatsTimezone = "UTC"
+	defaultStatsTopN     = 5
+	maxStatsTopN         = 50
+)
+
+var statsGranularities = map[string]bool{
+	"day":   true,
+	"week":  true,
+	"month": true,
+	"year":  true,
+}
+
+func (s *serverAPI) RequestUserStats(ctx context.Context, req *database.RequestUserStatsRequest) (*database.RequestUserStatsResponse, error) {
+	if req.GetUserID() == 0 {
+		appErr := apperror.BadRequestError(errors.New("empty user id"), 1078, "Error while requesting user stats")
+		logger.LogOnError(appErr, "Error in requesting user stats")
+		return nil, appErr
+	}
+	if !statsGranularities[req.GetGranularity()] {
+		appErr := apperror.BadRequestError(errors.New("unknown granularity"), 1079, "Error while requesting user stats")
+		logger.LogOnError(appErr, "Error in requesting user stats")
+		return nil, appErr
+	}
+	if req.GetDateTo() != 0 && req.GetDateTo() < req.GetDateFrom() {
+		appErr := apperror.BadRequestError(errors.New("invalid stats period"), 1080, "Error while requesting user stats")
+		logger.LogOnError(appErr, "Error in requesting user stats")
+		return nil, appErr
+	}
+	if req.GetBaseCurrency() != "" && !currencyCode.MatchString(req.GetBaseCurrency()) {
+		appErr := apperror.BadRequestError(errors.New("invalid base currency"), 1311, "Error while requesting user stats")
+		logger.LogOnError(appErr, "Error in requesting user stats")
+		return nil, appErr
+	}
+	// Postgres knows the IANA zones Go does, but not Go's "Local".
+	if _, err := time.LoadLocation(req.GetTimezone()); err != nil || req.GetTimezone() == "Local" {
+		appErr := apperror.BadRequestError(fmt.Errorf("unknown timezone %q", req.GetTimezone()), 1340, "Unknown timezone")
+		logger.LogOnError(appErr, "Error in requesting user stats")
+		return nil, appErr
+	}
+	stats, mes, err := s.db.RequestUserStats(ctx, req.GetUserID(), interpretatorStatsQuery(req))
+	if err != nil {
+		logger.LogOnError(err, "Error in requesting user stats")
+		return &database.RequestUserStatsResponse{ErrorMes: mes}, err
+	}
+	return interpretatorStatsResponse(stats, mes), nil
+}
+
+func interpretatorStatsQuery(req *database.RequestUserStatsRequest) model.StatsQuery {
+	q := model.StatsQuery{
+		DateFrom:     req.GetDateFrom(),
+		DateTo:       req.GetDateTo(),
+		Granularity:  req.GetGranularity(),
+		Timezone:     req.GetTimezone(),
+		TopN:         req.GetTopN(),
+		BaseCurrency: req.GetBaseCurrency(),
+		RateDate:     req.GetRateDate(),
+	}
+	if q.Timezone == "" {
+		q.Timezone = defaultStatsTimezone
+	}
+	if q.TopN <= 0 {
+		q.TopN = defaultStatsTopN
+	}
+	q.TopN = min(q.TopN, maxStatsTopN)
+	return q
+}
+
+func interpretatorStatsResponse(stats model.Stats, mes string) *database.RequestUserStatsResponse {
+	resp := &database.RequestUserStatsResponse{
+		Income:          stats.Income,
+		Expense:         stats.Expense,
+		Net:             stats.Net,
+		Categories:      make([]*database.CategoryTotal, 0, len(stats.Categories)),
+		Periods:         make([]*database.PeriodTotal, 0, len(stats.Periods)),
+		TopDescriptions: make([]*database.DescriptionTotal, 0, len(stats.TopDescriptions)),
+		ErrorMes:        mes,
+	}
+	for _, c := range stats.Categories {
+		resp.Categories = append(resp.Categories, &database.CategoryTotal{
+			Kategoria: c.Kategoria,
+			Type:      c.Type,
+			Amount:    c.Amount,
+			Count:     c.Count,
+		})
+	}
+	for _, p := range stats.Periods {
+		resp.Periods = append(resp.Periods, &database.PeriodTotal{
+			PeriodStart: p.PeriodStart,
+			Income:      p.Income,
+			Expense:     p.Expense,
+			Net:         p.Net,
+		})
+	}
+	for _, t := range stats.TopDescriptions {
+		resp.TopDescriptions = append(resp.TopDescriptions, &database.DescriptionTotal{
+			Description: t.Description,
+			Amount:      t.Amount,
+			Count:       t.Count,
+		})
+	}
+	return resp
+}
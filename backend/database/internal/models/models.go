@@ -1,5 +1,12 @@
 package model
 
+const (
+	TypeIncome  = "Пополнение/Доход"
+	TypeExpense = "Списание/Покупка"
+
+	KategoriaTransfer = "Перевод"
+)
+
 type Transaction struct {
 	ID          int64
 	Date        int64
@@ -7,4 +14,200 @@ type Transaction struct {
 	Type        string
 	Amount      int64
 	Description string
+	AccountID   int64
+	TransferID  int64
+	Currency    string
+	BaseAmount  *int64
+	// CategoryPending is set while a categorization job for the transaction
+	// is queued.
+	CategoryPending bool
+}
+
+type TransactionQuery struct {
+	DateFrom     int64
+	DateTo       int64
+	Kategorias   []string
+	Type         string
+	AmountMin    *int64
+	AmountMax    *int64
+	Description  string
+	AccountID    int64
+	SortBy       string
+	Cursor       string
+	Limit        int64
+	BaseCurrency string
+	RateDate     int64
+}
+
+type TransactionPage struct {
+	Transactions []Transaction
+	NextCursor   string
+	Total        int64
+}
+
+// Budget limits the monthly expenses of a category in BudgetCurrency. Spent
+// is converted into it; Added is the part of Spent from the transaction the
+// budgets were requested for.
+type Budget struct {
+	ID        int64
+	Kategoria string
+	Limit     int64
+	Spent     int64
+	Added     int64
+}
+
+const BudgetCurrency = "RUB"
+
+type Recurring struct {
+	ID          int64
+	UserID      int64
+	Kategoria   string
+	Type        string
+	Amount      int64
+	Description string
+	Frequency   string
+	StartDate   int64
+	EndDate     int64
+	NextDate    int64
+	Runs        int64
+	Paused      bool
+}
+
+type StatsQuery struct {
+	DateFrom     int64
+	DateTo       int64
+	Granularity  string
+	Timezone     string
+	TopN         int64
+	BaseCurrency string
+	RateDate     int64
+}
+
+type Stats struct {
+	Income          int64
+	Expense         int64
+	Net             int64
+	Categories      []CategoryTotal
+	Periods         []PeriodTotal
+	TopDescriptions []DescriptionTotal
+}
+
+type CategoryTotal struct {
+	Kategoria string
+	Type      string
+	Amount    int64
+	Count     int64
+}
+
+type PeriodTotal struct {
+	PeriodStart int64
+	Income      int64
+	Expense     int64
+	Net         int64
+}
+
+type DescriptionTotal struct {
+	Description string
+	Amount      int64
+	Count       int64
+}
+
+type Account struct {
+	ID             int64
+	Name           string
+	Currency       string
+	OpeningBalance int64
+	Balance        int64
+	IsDefault      bool
+}
+
+type Transfer struct {
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+	ToAmount      int64
+	Date          int64
+	Description   string
+}
+
+type TransferResult struct {
+	TransferID        int64
+	FromTransactionID int64
+	ToTransactionID   int64
+}
+
+// ExchangeRate is the price of Nominal units of Currency in roubles on Date
+// (YYYY-MM-DD). Value is a decimal string so that no precision is lost before
+// it reaches the database.
+type ExchangeRate struct {
+	Currency string
+	Date     string
+	Value    string
+	Nominal  int64
+}
+
+type ChatMessage struct {
+	ID        int64
+	Role      string
+	Content   string
+	CreatedAt int64
+}
+
+type Category struct {
+	ID       int64
+	Name     string
+	Icon     string
+	Color    string
+	ParentID int64
+	Type     string
+}
+
+type Rule struct {
+	ID                  int64
+	Priority            int64
+	DescriptionContains string
+	DescriptionRegex    string
+	AmountMin           int64
+	AmountMax           int64
+	Type                string
+	Kategoria           string
+	Global              bool
+}
+
+type CategoryCorrection struct {
+	ID            int64
+	TransactionID int64
+	Description   string
+	Type          string
+	Amount        int64
+	OldKategoria  string
+	NewKategoria  string
+	CreatedAt     int64
+}
+
+type CategorizationJob struct {
+	ID            int64
+	UserID        int64
+	TransactionID int64
+	Attempts      int64
+	Transaction   Transaction
+}
+
+// RateLimitState is the counter of one user and rate limited endpoint of the
+// manager: the token bucket as of UpdatedAt and the requests Used on Day.
+// Version grows with every write and is 0 for a counter never stored.
+type RateLimitState struct {
+	Tokens    float64
+	UpdatedAt int64
+	Day       int64
+	Used      int64
+	Version   int64
+}
+
+// Recategorization is a re-categorisation job of the manager. Data is the
+// job as the manager encodes it and is stored as is.
+type Recategorization struct {
+	ID     int64
+	Status string
+	Data   []byte
 }
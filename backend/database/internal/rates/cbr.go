@@ -0,0 +1,71 @@
+package rates
+
+import (
+	"bytes"
+	model "database/internal/models"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// cbrDaily is the daily rates document published by the Central Bank of
+// Russia (XML_daily.asp). It is encoded in windows-1251 and uses a decimal
+// comma.
+type cbrDaily struct {
+	Date    string `xml:"Date,attr"`
+	Valutes []struct {
+		CharCode string `xml:"CharCode"`
+		Nominal  string `xml:"Nominal"`
+		Value    string `xml:"Value"`
+	} `xml:"Valute"`
+}
+
+func ParseCBR(data []byte) ([]model.ExchangeRate, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = charsetReader
+	var doc cbrDaily
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode cbr xml: %w", err)
+	}
+	date, err := time.Parse("02.01.2006", doc.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cbr date %q", doc.Date)
+	}
+	if len(doc.Valutes) == 0 {
+		return nil, errors.New("cbr xml has no rates")
+	}
+	rates := make([]model.ExchangeRate, 0, len(doc.Valutes))
+	for _, v := range doc.Valutes {
+		nominal, err := strconv.ParseInt(strings.TrimSpace(v.Nominal), 10, 64)
+		if err != nil || nominal <= 0 {
+			return nil, fmt.Errorf("invalid nominal %q for %s", v.Nominal, v.CharCode)
+		}
+		value := strings.ReplaceAll(strings.TrimSpace(v.Value), ",", ".")
+		if f, err := strconv.ParseFloat(value, 64); err != nil || f <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s", v.Value, v.CharCode)
+		}
+		rates = append(rates, model.ExchangeRate{
+			Currency: strings.ToUpper(strings.TrimSpace(v.CharCode)),
+			Date:     date.Format(time.DateOnly),
+			Value:    value,
+			Nominal:  nominal,
+		})
+	}
+	return rates, nil
+}
+
+func charsetReader(label string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(label) {
+	case "windows-1251", "cp1251":
+		return charmap.Windows1251.NewDecoder().Reader(input), nil
+	case "utf-8", "":
+		return input, nil
+	}
+	return nil, fmt.Errorf("unsupported charset %q", label)
+}
@@ -0,0 +1,192 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/lib/pq"
+)
+
+const defaultAccountName = "Основной счёт"
+
+// accountRef selects the user's account with the given id, or the default
+// account when the id is 0. It yields NULL for a foreign or missing account,
+// which the NOT NULL constraint on transactions.account_id rejects.
+func accountRef(uid, account string) string {
+	return `(SELECT id FROM accounts WHERE ` + accountCond(uid, account) + `)`
+}
+
+// accountCurrency is the currency of the account selected by accountRef.
+func accountCurrency(uid, account string) string {
+	return `(SELECT currency FROM accounts WHERE ` + accountCond(uid, account) + `)`
+}
+
+func accountCond(uid, account string) string {
+	return `user_id = ` + uid + ` AND (id = ` + account + ` OR (` + account + ` = 0 AND is_default))`
+}
+
+func (d *DatabaseRepo) AddAccount(ctx context.Context, uid int64, a model.Account) (int64, string, error) {
+	query := `INSERT INTO accounts (user_id, name, currency, opening_balance) VALUES ($1, $2, $3, $4) RETURNING id`
+	var id int64
+	err := d.db.QueryRowContext(ctx, query, uid, a.Name, a.Currency, a.OpeningBalance).Scan(&id)
+	if isPQError(err, "23505") {
+		return 0, "Account already exists", apperror.CustomError(err, http.StatusConflict, 1142, "Account with this name already exists")
+	}
+	if err != nil {
+		return 0, "failed to add account", apperror.SystemError(err, 1143, "Failed to add account")
+	}
+	return id, "success", nil
+}
+
+// EditAccount updates the account. Marking it as default takes the flag away
+// from the previous default account in the same transaction.
+func (d *DatabaseRepo) EditAccount(ctx context.Context, uid int64, a model.Account) (string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "failed to edit account", apperror.SystemError(err, 1144, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	if a.IsDefault {
+		query := `UPDATE accounts SET is_default = FALSE WHERE user_id = $1 AND is_default AND id <> $2`
+		if _, err := tx.ExecContext(ctx, query, uid, a.ID); err != nil {
+			return "failed to edit account", apperror.SystemError(err, 1145, "Failed to reset default account")
+		}
+	}
+	query := `UPDATE accounts SET name = $1, currency = $2, opening_balance = $3, is_default = is_default OR $4
+		WHERE id = $5 AND user_id = $6`
+	res, err := tx.ExecContext(ctx, query, a.Name, a.Currency, a.OpeningBalance, a.IsDefault, a.ID, uid)
+	if isPQError(err, "23505") {
+		return "Account already exists", apperror.CustomError(err, http.StatusConflict, 1142, "Account with this name already exists")
+	}
+	if err != nil {
+		return "failed to edit account", apperror.SystemError(err, 1146, "Failed to edit account")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to edit account", apperror.SystemError(err, 1147, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, tx, "accounts", "Account", a.ID, uid, apperror.NotFoundError(errors.New("account not found"), 1148, "Account not found"), 1253)
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to edit account", apperror.SystemError(err, 1149, "Failed to commit account")
+	}
+	return "success", nil
+}
+
+// DeleteAccount removes an empty account. The default account and accounts
+// that still have transactions are kept.
+func (d *DatabaseRepo) DeleteAccount(ctx context.Context, uid, aid int64) (string, error) {
+	query := `DELETE FROM accounts WHERE id = $1 AND user_id = $2 AND NOT is_default`
+	res, err := d.db.ExecContext(ctx, query, aid, uid)
+	if isPQError(err, "23503") {
+		return "Account has transactions", apperror.BadRequestError(err, 1150, "Account has transactions")
+	}
+	if err != nil {
+		return "failed to delete account", apperror.SystemError(err, 1151, "Failed to delete account")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to delete account", apperror.SystemError(err, 1152, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "accounts", "Account", aid, uid, apperror.NotFoundError(errors.New("account not found or default"), 1153, "Account not found or is the default account"), 1253)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, string, error) {
+	query := `SELECT a.id, a.name, a.currency, a.opening_balance, a.is_default,
+			a.opening_balance + COALESCE(SUM(CASE t.type WHEN $2 THEN t.amount WHEN $3 THEN -t.amount ELSE 0 END), 0)
+		FROM accounts a
+		LEFT JOIN transactions t ON t.account_id = a.id
+		WHERE a.user_id = $1
+		GROUP BY a.id
+		ORDER BY a.is_default DESC, a.name`
+	rows, err := d.db.QueryContext(ctx, query, uid, model.TypeIncome, model.TypeExpense)
+	if err != nil {
+		return []model.Account{}, "failed to get accounts", apperror.SystemError(err, 1154, "Failed to get accounts")
+	}
+	defer rows.Close()
+	accounts := []model.Account{}
+	for rows.Next() {
+		var a model.Account
+		if err := rows.Scan(&a.ID, &a.Name, &a.Currency, &a.OpeningBalance, &a.IsDefault, &a.Balance); err != nil {
+			return []model.Account{}, "failed to get accounts", apperror.SystemError(err, 1155, "Error scanning account")
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.Account{}, "failed to get accounts", apperror.SystemError(err, 1156, "Error getting accounts")
+	}
+	return accounts, "success", nil
+}
+
+// Transfer writes both legs of a transfer in one transaction: an expense on
+// the source account and an income on the destination, linked by a shared
+// transfer id. Between accounts in different currencies the destination
+// amount defaults to the source amount converted at the rate of the day.
+func (d *DatabaseRepo) Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1157, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var fromCurrency, toCurrency string
+	query := `SELECT currency FROM accounts WHERE id = $1 AND user_id = $2`
+	if err := tx.QueryRowContext(ctx, query, t.FromAccountID, uid).Scan(&fromCurrency); errors.Is(err, sql.ErrNoRows) {
+		return model.TransferResult{}, "Account not found", apperror.NotFoundError(errors.New("source account not found"), 1158, "Source account not found")
+	} else if err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1159, "Failed to get source account")
+	}
+	if err := tx.QueryRowContext(ctx, query, t.ToAccountID, uid).Scan(&toCurrency); errors.Is(err, sql.ErrNoRows) {
+		return model.TransferResult{}, "Account not found", apperror.NotFoundError(errors.New("destination account not found"), 1160, "Destination account not found")
+	} else if err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1161, "Failed to get destination account")
+	}
+	toAmount := t.ToAmount
+	if toAmount == 0 {
+		toAmount = t.Amount
+		if fromCurrency != toCurrency {
+			query := `SELECT ROUND($1::bigint * rub_rate($2, d) / rub_rate($3, d))::bigint
+				FROM (SELECT (to_timestamp($4::bigint / 1000.0) AT TIME ZONE '` + ratesTimezone + `')::date AS d) day`
+			var converted sql.NullInt64
+			if err := tx.QueryRowContext(ctx, query, t.Amount, fromCurrency, toCurrency, t.Date).Scan(&converted); err != nil {
+				return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1166, "Failed to convert transfer amount")
+			}
+			if !converted.Valid {
+				return model.TransferResult{}, "Destination amount required", apperror.BadRequestError(errors.New("no exchange rate"), 1162, "No exchange rate for the transfer date, destination amount is required")
+			}
+			toAmount = converted.Int64
+		}
+	}
+
+	var res model.TransferResult
+	if err := tx.QueryRowContext(ctx, `SELECT nextval('transfers_id_seq')`).Scan(&res.TransferID); err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1163, "Failed to allocate transfer id")
+	}
+	query = `INSERT INTO transactions (user_id, date, category, type, amount, description, account_id, transfer_id, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+	err = tx.QueryRowContext(ctx, query, uid, t.Date, model.KategoriaTransfer, model.TypeExpense, t.Amount, t.Description, t.FromAccountID, res.TransferID, fromCurrency).Scan(&res.FromTransactionID)
+	if err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1164, "Failed to add transfer")
+	}
+	err = tx.QueryRowContext(ctx, query, uid, t.Date, model.KategoriaTransfer, model.TypeIncome, toAmount, t.Description, t.ToAccountID, res.TransferID, toCurrency).Scan(&res.ToTransactionID)
+	if err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1164, "Failed to add transfer")
+	}
+	if err := tx.Commit(); err != nil {
+		return model.TransferResult{}, "failed to transfer", apperror.SystemError(err, 1165, "Failed to commit transfer")
+	}
+	return res, "success", nil
+}
+
+func isPQError(err error, code pq.ErrorCode) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == code
+}
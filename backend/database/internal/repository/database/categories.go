@@ -0,0 +1,158 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+func (d *DatabaseRepo) AddCategory(ctx context.Context, uid int64, c model.Category) (int64, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "failed to add category", apperror.SystemError(err, 1180, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	if mes, err := checkCategoryParent(ctx, tx, uid, c); err != nil {
+		return 0, mes, err
+	}
+	query := `INSERT INTO categories (user_id, name, icon, color, parent_id, type)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6) RETURNING id`
+	var id int64
+	err = tx.QueryRowContext(ctx, query, uid, c.Name, c.Icon, c.Color, c.ParentID, c.Type).Scan(&id)
+	if isPQError(err, "23505") {
+		return 0, "Category already exists", apperror.CustomError(err, http.StatusConflict, 1181, "Category with this name already exists")
+	}
+	if err != nil {
+		return 0, "failed to add category", apperror.SystemError(err, 1182, "Failed to add category")
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, "failed to add category", apperror.SystemError(err, 1183, "Failed to commit category")
+	}
+	return id, "success", nil
+}
+
+// EditCategory updates the category. A rename is carried over to the
+// transactions, budgets, recurring templates and rules that use the old name, and a
+// type change to the subcategories.
+func (d *DatabaseRepo) EditCategory(ctx context.Context, uid int64, c model.Category) (string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "failed to edit category", apperror.SystemError(err, 1184, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var oldName string
+	var children int64
+	query := `SELECT name, (SELECT COUNT(*) FROM categories WHERE user_id = $2 AND parent_id = $1)
+		FROM categories WHERE id = $1 AND user_id = $2 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, query, c.ID, uid).Scan(&oldName, &children); errors.Is(err, sql.ErrNoRows) {
+		return missingRow(ctx, tx, "categories", "Category", c.ID, uid, apperror.NotFoundError(errors.New("category not found"), 1185, "Category not found"), 1254)
+	} else if err != nil {
+		return "failed to edit category", apperror.SystemError(err, 1186, "Failed to get category")
+	}
+	if c.ParentID != 0 && children > 0 {
+		return "Category has subcategories", apperror.BadRequestError(errors.New("category has subcategories"), 1187, "A category with subcategories cannot become a subcategory")
+	}
+	if mes, err := checkCategoryParent(ctx, tx, uid, c); err != nil {
+		return mes, err
+	}
+
+	query = `UPDATE categories SET name = $1, icon = $2, color = $3, parent_id = NULLIF($4, 0), type = $5
+		WHERE id = $6 AND user_id = $7`
+	_, err = tx.ExecContext(ctx, query, c.Name, c.Icon, c.Color, c.ParentID, c.Type, c.ID, uid)
+	if isPQError(err, "23505") {
+		return "Category already exists", apperror.CustomError(err, http.StatusConflict, 1181, "Category with this name already exists")
+	}
+	if err != nil {
+		return "failed to edit category", apperror.SystemError(err, 1188, "Failed to edit category")
+	}
+	query = `UPDATE categories SET type = $1 WHERE user_id = $2 AND parent_id = $3 AND type <> $1`
+	if _, err := tx.ExecContext(ctx, query, c.Type, uid, c.ID); err != nil {
+		return "failed to edit category", apperror.SystemError(err, 1189, "Failed to update subcategories")
+	}
+	if c.Name != oldName {
+		for _, table := range []string{"transactions", "budgets", "recurring_transactions", "categorization_rules"} {
+			query := `UPDATE ` + table + ` SET category = $1 WHERE user_id = $2 AND category = $3`
+			_, err := tx.ExecContext(ctx, query, c.Name, uid, oldName)
+			if isPQError(err, "23505") {
+				return "Category already exists", apperror.CustomError(err, http.StatusConflict, 1181, "Category with this name already exists")
+			}
+			if err != nil {
+				return "failed to edit category", apperror.SystemError(err, 1190, "Failed to rename category")
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to edit category", apperror.SystemError(err, 1191, "Failed to commit category")
+	}
+	return "success", nil
+}
+
+// DeleteCategory removes the category. Its subcategories become top-level and
+// transactions keep the category name.
+func (d *DatabaseRepo) DeleteCategory(ctx context.Context, uid, cid int64) (string, error) {
+	query := `DELETE FROM categories WHERE id = $1 AND user_id = $2`
+	res, err := d.db.ExecContext(ctx, query, cid, uid)
+	if err != nil {
+		return "failed to delete category", apperror.SystemError(err, 1192, "Failed to delete category")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to delete category", apperror.SystemError(err, 1193, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "categories", "Category", cid, uid, apperror.NotFoundError(errors.New("category not found"), 1185, "Category not found"), 1254)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, string, error) {
+	query := `SELECT id, name, icon, color, COALESCE(parent_id, 0), type FROM categories WHERE user_id = $1 ORDER BY type, name`
+	rows, err := d.db.QueryContext(ctx, query, uid)
+	if err != nil {
+		return []model.Category{}, "failed to get categories", apperror.SystemError(err, 1194, "Failed to get categories")
+	}
+	defer rows.Close()
+	categories := []model.Category{}
+	for rows.Next() {
+		var c model.Category
+		if err := rows.Scan(&c.ID, &c.Name, &c.Icon, &c.Color, &c.ParentID, &c.Type); err != nil {
+			return []model.Category{}, "failed to get categories", apperror.SystemError(err, 1195, "Error scanning category")
+		}
+		categories = append(categories, c)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.Category{}, "failed to get categories", apperror.SystemError(err, 1196, "Error getting categories")
+	}
+	return categories, "success", nil
+}
+
+// checkCategoryParent allows a single level of nesting: the parent must be one
+// of the user's top-level categories of the same type.
+func checkCategoryParent(ctx context.Context, tx *sql.Tx, uid int64, c model.Category) (string, error) {
+	if c.ParentID == 0 {
+		return "", nil
+	}
+	if c.ParentID == c.ID {
+		return "Invalid parent category", apperror.BadRequestError(errors.New("category is its own parent"), 1197, "A category cannot be its own parent")
+	}
+	var parentType string
+	var grandparent sql.NullInt64
+	query := `SELECT type, parent_id FROM categories WHERE id = $1 AND user_id = $2`
+	if err := tx.QueryRowContext(ctx, query, c.ParentID, uid).Scan(&parentType, &grandparent); errors.Is(err, sql.ErrNoRows) {
+		return "Parent category not found", apperror.NotFoundError(errors.New("parent category not found"), 1198, "Parent category not found")
+	} else if err != nil {
+		return "failed to check parent category", apperror.SystemError(err, 1199, "Failed to get parent category")
+	}
+	if grandparent.Valid {
+		return "Invalid parent category", apperror.BadRequestError(errors.New("parent is a subcategory"), 1197, "Subcategories cannot have subcategories")
+	}
+	if parentType != c.Type {
+		return "Invalid parent category", apperror.BadRequestError(errors.New("parent type mismatch"), 1197, "Parent category must have the same type")
+	}
+	return "", nil
+}
@@ -0,0 +1,63 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// AddChatMessages stores a whole exchange at once, so a question is never kept
+// without its answer.
+func (d *DatabaseRepo) AddChatMessages(ctx context.Context, uid int64, messages []model.ChatMessage) (string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "failed to add chat messages", apperror.SystemError(err, 1171, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	query := `INSERT INTO chat_messages (user_id, role, content, created_at) VALUES ($1, $2, $3, $4)`
+	for _, m := range messages {
+		if _, err := tx.ExecContext(ctx, query, uid, m.Role, m.Content, m.CreatedAt); err != nil {
+			return "failed to add chat messages", apperror.SystemError(err, 1172, "Failed to add chat message")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to add chat messages", apperror.SystemError(err, 1173, "Failed to commit chat messages")
+	}
+	return "success", nil
+}
+
+// RequestChatMessages returns the latest limit messages older than before
+// (any message when before is 0) in chronological order.
+func (d *DatabaseRepo) RequestChatMessages(ctx context.Context, uid, limit, before int64) ([]model.ChatMessage, string, error) {
+	query := `SELECT id, role, content, created_at FROM (
+			SELECT id, role, content, created_at FROM chat_messages
+			WHERE user_id = $1 AND ($2 = 0 OR id < $2)
+			ORDER BY id DESC LIMIT $3
+		) latest ORDER BY id`
+	rows, err := d.db.QueryContext(ctx, query, uid, before, limit)
+	if err != nil {
+		return []model.ChatMessage{}, "failed to get chat messages", apperror.SystemError(err, 1174, "Failed to get chat messages")
+	}
+	defer rows.Close()
+	messages := []model.ChatMessage{}
+	for rows.Next() {
+		var m model.ChatMessage
+		if err := rows.Scan(&m.ID, &m.Role, &m.Content, &m.CreatedAt); err != nil {
+			return []model.ChatMessage{}, "failed to get chat messages", apperror.SystemError(err, 1175, "Error scanning chat message")
+		}
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.ChatMessage{}, "failed to get chat messages", apperror.SystemError(err, 1176, "Error getting chat messages")
+	}
+	return messages, "success", nil
+}
+
+func (d *DatabaseRepo) ClearChatMessages(ctx context.Context, uid int64) (string, error) {
+	query := `DELETE FROM chat_messages WHERE user_id = $1`
+	if _, err := d.db.ExecContext(ctx, query, uid); err != nil {
+		return "failed to clear chat messages", apperror.SystemError(err, 1177, "Failed to clear chat messages")
+	}
+	return "success", nil
+}
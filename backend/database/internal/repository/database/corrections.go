@@ -0,0 +1,32 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// RequestCategoryCorrections returns the latest category corrections of the
+// user, newest first.
+func (d *DatabaseRepo) RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, string, error) {
+	query := `SELECT id, COALESCE(transaction_id, 0), description, type, amount, old_category, new_category, created_at
+		FROM category_corrections WHERE user_id = $1 ORDER BY id DESC LIMIT $2`
+	rows, err := d.db.QueryContext(ctx, query, uid, limit)
+	if err != nil {
+		return []model.CategoryCorrection{}, "failed to get corrections", apperror.SystemError(err, 1214, "Failed to get category corrections")
+	}
+	defer rows.Close()
+	corrections := []model.CategoryCorrection{}
+	for rows.Next() {
+		var c model.CategoryCorrection
+		if err := rows.Scan(&c.ID, &c.TransactionID, &c.Description, &c.Type, &c.Amount, &c.OldKategoria, &c.NewKategoria, &c.CreatedAt); err != nil {
+			return []model.CategoryCorrection{}, "failed to get corrections", apperror.SystemError(err, 1215, "Error scanning category correction")
+		}
+		corrections = append(corrections, c)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.CategoryCorrection{}, "failed to get corrections", apperror.SystemError(err, 1216, "Error getting category corrections")
+	}
+	return corrections, "success", nil
+}
@@ -6,10 +6,14 @@ import (
 	"database/internal/migrator"
 	model "database/internal/models"
 	"database/sql"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/PrototypeSirius/ruglogger/apperror"
 	"github.com/PrototypeSirius/ruglogger/logger"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 )
 
@@ -46,92 +50,395 @@ func New(log *logrus.Logger, cfg config.DatabaseConfig) (*DatabaseRepo, func() e
 	return &DatabaseRepo{db: db}, db.Close
 }
 
-func (d *DatabaseRepo) AddTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error) {
-	query := `INSERT INTO transactions (user_id, date, category, type, amount, description) VALUES ($1, $2, $3, $4, $5, $6)`
-	_, err := d.db.ExecContext(ctx, query, uid, t.Date, t.Kategoria, t.Type, t.Amount, t.Description)
+// AddTransaction inserts t and returns its id. With categorize the row is
+// stored with a pending category and a categorization job is queued for it in
+// the same statement.
+func (d *DatabaseRepo) AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, string, error) {
+	id, err := insertTransaction(ctx, d.db, uid, t, categorize)
 	if err != nil {
-		return "failed to add transaction", apperror.BadRequestError(err, 1081, "Failed to add transaction")
+		return 0, "failed to add transaction", apperror.SystemError(err, 1081, "Failed to add transaction")
 	}
-	return "", nil
+	return id, "", nil
+}
+
+// rowQuerier is a *sql.DB or a *sql.Tx.
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
 }
 
+// insertTransaction adds t to the default account unless it names another
+// one, and queues it for categorisation when categorize is set.
+func insertTransaction(ctx context.Context, q rowQuerier, uid int64, t model.Transaction, categorize bool) (int64, error) {
+	query := `WITH inserted AS (
+			INSERT INTO transactions (user_id, date, category, type, amount, description, account_id, currency, category_pending)
+			VALUES ($1, $2, $3, $4, $5, $6, ` + accountRef("$1", "$7") + `, COALESCE(NULLIF($8, ''), ` + accountCurrency("$1", "$7") + `), $9)
+			RETURNING id
+		), job AS (
+			INSERT INTO categorization_jobs (user_id, transaction_id, run_at, created_at, updated_at)
+			SELECT $1, id, $10, $10, $10 FROM inserted WHERE $9
+		)
+		SELECT id FROM inserted`
+	var id int64
+	err := q.QueryRowContext(ctx, query, uid, t.Date, t.Kategoria, t.Type, t.Amount, t.Description, t.AccountID, t.Currency, categorize, time.Now().UnixMilli()).Scan(&id)
+	return id, err
+}
+
+// AddTransactions inserts all transactions in one database transaction. The
+// returned slice holds the new row ids in input order; with skipDuplicates a
+// row equal to an existing one on the same account (same date, type, amount
+// and description) is not inserted and gets id 0.
+func (d *DatabaseRepo) AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, "failed to add transactions", apperror.SystemError(err, 1124, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	query := `WITH account AS (SELECT ` + accountRef("$1::bigint", "$7::bigint") + ` AS id, ` + accountCurrency("$1::bigint", "$7::bigint") + ` AS currency)
+		INSERT INTO transactions (user_id, date, category, type, amount, description, account_id, currency)
+		SELECT $1::bigint, $2::bigint, $3::text, $4::text, $5::bigint, $6::text, account.id, COALESCE(NULLIF($9::text, ''), account.currency) FROM account
+		WHERE NOT $8::boolean OR NOT EXISTS (
+			SELECT 1 FROM transactions
+			WHERE user_id = $1 AND account_id = account.id AND date = $2 AND type = $4 AND amount = $5 AND description = $6
+		)
+		RETURNING id`
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, "failed to add transactions", apperror.SystemError(err, 1125, "Failed to prepare insert")
+	}
+	defer stmt.Close()
+	ids := make([]int64, 0, len(ts))
+	for _, t := range ts {
+		var id int64
+		err := stmt.QueryRowContext(ctx, uid, t.Date, t.Kategoria, t.Type, t.Amount, t.Description, t.AccountID, skipDuplicates, t.Currency).Scan(&id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, "failed to add transactions", apperror.SystemError(err, 1126, "Failed to add transactions")
+		}
+		ids = append(ids, id)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, "failed to add transactions", apperror.SystemError(err, 1127, "Failed to commit transactions")
+	}
+	return ids, "success", nil
+}
+
+// AddUser creates the user together with their default account. Both inserts
+// are idempotent, so it is safe to call on every login.
 func (d *DatabaseRepo) AddUser(ctx context.Context, uid int64) (string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "failed to add user", apperror.SystemError(err, 1139, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
 	query := `INSERT INTO users (id) VALUES ($1) ON CONFLICT (id) DO NOTHING`
-	_, err := d.db.ExecContext(ctx, query, uid)
+	res, err := tx.ExecContext(ctx, query, uid)
 	if err != nil {
-		return "failed to add user", apperror.BadRequestError(err, 1082, "Failed to add user")
+		return "failed to add user", apperror.SystemError(err, 1082, "Failed to add user")
+	}
+	created, err := res.RowsAffected()
+	if err != nil {
+		return "failed to add user", apperror.SystemError(err, 1178, "Error getting rows affected")
+	}
+	// Only new users get the default categories, so deleted ones stay deleted.
+	if created > 0 {
+		if _, err := tx.ExecContext(ctx, `SELECT add_default_categories($1)`, uid); err != nil {
+			return "failed to add user", apperror.SystemError(err, 1179, "Failed to add default categories")
+		}
+	}
+	query = `INSERT INTO accounts (user_id, name, is_default) SELECT $1, $2, TRUE
+		WHERE NOT EXISTS (SELECT 1 FROM accounts WHERE user_id = $1 AND is_default)`
+	if _, err := tx.ExecContext(ctx, query, uid, defaultAccountName); err != nil {
+		return "failed to add user", apperror.SystemError(err, 1140, "Failed to add default account")
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to add user", apperror.SystemError(err, 1141, "Failed to commit user")
 	}
 	return "success", nil
 }
 
 func (d *DatabaseRepo) DeleteTransaction(ctx context.Context, uid, tid int64) (string, error) {
-	query := `DELETE FROM transactions WHERE id = $1`
-	res, err := d.db.ExecContext(ctx, query, tid)
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
-		return "failed to delete transaction", apperror.BadRequestError(err, 1083, "Failed to delete transaction")
+		return "failed to delete transaction", apperror.SystemError(err, 1233, "Failed to begin transaction")
 	}
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return "failed to delete transaction", apperror.BadRequestError(err, 1084, "Error getting rows affected")
+	defer tx.Rollback()
+	if mes, err := lockOwnTransaction(ctx, tx, uid, tid); err != nil {
+		return mes, err
 	}
-	if rows == 0 {
-		return "Transaction not found", apperror.BadRequestError(err, 1085, "Transaction not found")
+	// Both legs of a transfer are removed together.
+	query := `DELETE FROM transactions WHERE user_id = $2 AND (id = $1
+		OR transfer_id = (SELECT transfer_id FROM transactions WHERE id = $1))`
+	if _, err := tx.ExecContext(ctx, query, tid, uid); err != nil {
+		return "failed to delete transaction", apperror.SystemError(err, 1083, "Failed to delete transaction")
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to delete transaction", apperror.SystemError(err, 1234, "Failed to commit transaction")
 	}
 	return "success", nil
 }
 
+// lockOwnTransaction locks the transaction for the rest of tx. A missing
+// transaction and one of another user fail differently, so callers can answer
+// with not found and forbidden respectively.
+func lockOwnTransaction(ctx context.Context, tx *sql.Tx, uid, tid int64) (string, error) {
+	var owner int64
+	err := tx.QueryRowContext(ctx, `SELECT user_id FROM transactions WHERE id = $1 FOR UPDATE`, tid).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "Transaction not found", apperror.NotFoundError(err, 1085, "Transaction not found")
+	}
+	if err != nil {
+		return "failed to get transaction", apperror.SystemError(err, 1231, "Failed to get transaction")
+	}
+	if owner != uid {
+		return "Transaction belongs to another user", apperror.CustomError(fmt.Errorf("transaction %d belongs to user %d, not %d", tid, owner, uid), http.StatusForbidden, 1232, "Transaction belongs to another user")
+	}
+	return "", nil
+}
+
+// missingRow explains a write to row id of table that matched nothing for
+// uid. A row of another user gives forbidden with forbiddenCode, like
+// lockOwnTransaction does; otherwise notFound is returned. name is the
+// row's kind in the messages.
+func missingRow(ctx context.Context, q rowQuerier, table, name string, id, uid int64, notFound *apperror.AppError, forbiddenCode int) (string, error) {
+	var owner sql.NullInt64
+	err := q.QueryRowContext(ctx, `SELECT user_id FROM `+table+` WHERE id = $1`, id).Scan(&owner)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "failed to get owner", apperror.SystemError(err, 1250, "Failed to get owner of "+table)
+	}
+	if err == nil && owner.Valid && owner.Int64 != uid {
+		return name + " belongs to another user", apperror.CustomError(fmt.Errorf("%s %d belongs to user %d, not %d", table, id, owner.Int64, uid), http.StatusForbidden, forbiddenCode, name+" belongs to another user")
+	}
+	return notFound.Message, notFound
+}
+
 func (d *DatabaseRepo) DeleteUser(ctx context.Context, uid int64) (string, error) {
 	query := `DELETE FROM users WHERE id = $1`
 	res, err := d.db.ExecContext(ctx, query, uid)
 	if err != nil {
-		return "failed to delete user", apperror.BadRequestError(err, 1086, "Failed to delete user")
+		return "failed to delete user", apperror.SystemError(err, 1086, "Failed to delete user")
 	}
 	rows, err := res.RowsAffected()
 	if err != nil {
-		return "failed to delete user", apperror.BadRequestError(err, 1087, "Error getting rows affected")
+		return "failed to delete user", apperror.SystemError(err, 1087, "Error getting rows affected")
 	}
 	if rows == 0 {
-		return "User not found", apperror.BadRequestError(err, 1088, "User not found")
+		return "User not found", apperror.NotFoundError(errors.New("user not found"), 1088, "User not found")
 	}
 	return "success", nil
 }
 
-func (d *DatabaseRepo) EditTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error) {
-	query := `UPDATE transactions SET date = $1, category = $2, type = $3, amount = $4, description = $5 WHERE id = $6 AND user_id = $7`
-	res, err := d.db.ExecContext(ctx, query, t.Date, t.Kategoria, t.Type, t.Amount, t.Description, t.ID, uid)
+// EditTransaction updates the transaction and reports whether the user changed
+// its category. A non-zero AccountID moves it to that account, which must be
+// the user's own. Such a change is recorded as a category correction in the same
+// transaction; transfers are never recorded.
+func (d *DatabaseRepo) EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
-		return "failed to edit transaction", apperror.BadRequestError(err, 1089, "Failed to edit transaction")
+		return false, "failed to edit transaction", apperror.SystemError(err, 1210, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	if mes, err := lockOwnTransaction(ctx, tx, uid, t.ID); err != nil {
+		return false, mes, err
+	}
+	var oldKategoria string
+	var transfer bool
+	query := `SELECT category, transfer_id IS NOT NULL FROM transactions WHERE id = $1 AND user_id = $2`
+	err = tx.QueryRowContext(ctx, query, t.ID, uid).Scan(&oldKategoria, &transfer)
+	if err != nil {
+		return false, "failed to edit transaction", apperror.SystemError(err, 1211, "Failed to get transaction")
+	}
+	if t.AccountID != 0 {
+		var own bool
+		query = `SELECT EXISTS (SELECT 1 FROM accounts WHERE id = $1 AND user_id = $2)`
+		if err := tx.QueryRowContext(ctx, query, t.AccountID, uid).Scan(&own); err != nil {
+			return false, "failed to edit transaction", apperror.SystemError(err, 1256, "Failed to get account")
+		}
+		if !own {
+			mes, err := missingRow(ctx, tx, "accounts", "Account", t.AccountID, uid, apperror.NotFoundError(errors.New("account not found"), 1257, "Account not found"), 1253)
+			return false, mes, err
+		}
+	}
+	query = `UPDATE transactions SET date = $1, category = $2, type = $3, amount = $4, description = $5,
+			category_pending = category_pending AND $2 = '',
+			account_id = CASE WHEN $8 = 0 THEN account_id ELSE $8 END,
+			currency = COALESCE(NULLIF($9, ''), currency)
+		WHERE id = $6 AND user_id = $7`
+	res, err := tx.ExecContext(ctx, query, t.Date, t.Kategoria, t.Type, t.Amount, t.Description, t.ID, uid, t.AccountID, t.Currency)
+	if err != nil {
+		return false, "failed to edit transaction", apperror.SystemError(err, 1089, "Failed to edit transaction")
 	}
 	rows, err := res.RowsAffected()
 	if err != nil {
-		return "failed to edit transaction", apperror.BadRequestError(err, 1090, "Error getting rows affected")
+		return false, "failed to edit transaction", apperror.SystemError(err, 1090, "Error getting rows affected")
 	}
 	if rows == 0 {
-		return "Transaction not found", apperror.BadRequestError(err, 1091, "Transaction not found")
+		return false, "Transaction not found", apperror.NotFoundError(errors.New("transaction not found"), 1091, "Transaction not found")
 	}
-	return "success", nil
+	corrected := !transfer && t.Kategoria != "" && t.Kategoria != oldKategoria
+	if corrected {
+		query = `INSERT INTO category_corrections (user_id, transaction_id, description, type, amount, old_category, new_category, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		_, err := tx.ExecContext(ctx, query, uid, t.ID, t.Description, t.Type, t.Amount, oldKategoria, t.Kategoria, time.Now().UnixMilli())
+		if err != nil {
+			return false, "failed to edit transaction", apperror.SystemError(err, 1212, "Failed to record category correction")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, "failed to edit transaction", apperror.SystemError(err, 1213, "Failed to commit transaction")
+	}
+	return corrected, "success", nil
 }
 
-func (d *DatabaseRepo) RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, string, error) {
-	query := `SELECT id, date, category, type, amount, description FROM transactions WHERE user_id = $1 ORDER BY date DESC`
-	rows, err := d.db.QueryContext(ctx, query, uid)
+// RecategorizeTransaction sets the category chosen by the categoriser, but
+// only while the transaction still has the previous category, so a change the
+// user made in the meantime wins. Transfers are never recategorised.
+func (d *DatabaseRepo) RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, string, error) {
+	query := `UPDATE transactions SET category = $1, category_pending = FALSE
+		WHERE id = $2 AND user_id = $3 AND category = $4 AND transfer_id IS NULL`
+	res, err := d.db.ExecContext(ctx, query, kategoria, tid, uid, previous)
 	if err != nil {
-		return []model.Transaction{}, "failed to get transactions", apperror.BadRequestError(err, 1092, "Failed to get transactions")
+		return false, "failed to recategorize transaction", apperror.SystemError(err, 1229, "Failed to recategorize transaction")
 	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, "failed to recategorize transaction", apperror.SystemError(err, 1230, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return false, "category has changed", nil
+	}
+	return true, "success", nil
+}
+
+func (d *DatabaseRepo) RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, string, error) {
+	sortBy, key, err := resolveSort(q.SortBy)
+	if err != nil {
+		return model.TransactionPage{}, "invalid sort key", err
+	}
+	where := buildTransactionWhere(uid, q)
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM transactions WHERE ` + where.String()
+	if err := d.db.QueryRowContext(ctx, countQuery, where.args...).Scan(&total); err != nil {
+		return model.TransactionPage{}, "failed to get transactions", apperror.SystemError(err, 1098, "Failed to count transactions")
+	}
+	if err := applyCursor(where, sortBy, key, q.Cursor); err != nil {
+		return model.TransactionPage{}, "invalid cursor", err
+	}
+	baseAmount := "NULL::bigint"
+	if q.BaseCurrency != "" {
+		baseAmount = convertedAmount(where.arg(q.BaseCurrency), where.arg(q.RateDate))
+	}
+	query := `SELECT id, date, category, type, amount, description, account_id, COALESCE(transfer_id, 0), currency, category_pending, ` + baseAmount + `
+		FROM transactions WHERE ` + where.String() + " " + orderClause(key)
+	limit := q.Limit
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit+1)
+	}
+	rows, err := d.db.QueryContext(ctx, query, where.args...)
+	if err != nil {
+		return model.TransactionPage{}, "failed to get transactions", apperror.SystemError(err, 1092, "Failed to get transactions")
+	}
+	defer rows.Close()
 	var transactions []model.Transaction
 	for rows.Next() {
 		var t model.Transaction
-		err := rows.Scan(&t.ID, &t.Date, &t.Kategoria, &t.Type, &t.Amount, &t.Description)
+		var base sql.NullInt64
+		err := rows.Scan(&t.ID, &t.Date, &t.Kategoria, &t.Type, &t.Amount, &t.Description, &t.AccountID, &t.TransferID, &t.Currency, &t.CategoryPending, &base)
 		if err != nil {
-			return []model.Transaction{}, "failed to get transactions", apperror.BadRequestError(err, 1093, "Error scanning transaction")
+			return model.TransactionPage{}, "failed to get transactions", apperror.SystemError(err, 1093, "Error scanning transaction")
+		}
+		if base.Valid {
+			t.BaseAmount = &base.Int64
 		}
 		transactions = append(transactions, t)
 	}
 	if err := rows.Err(); err != nil {
-		return []model.Transaction{}, "failed to get transactions", apperror.BadRequestError(err, 1094, "Error getting transactions")
+		return model.TransactionPage{}, "failed to get transactions", apperror.SystemError(err, 1094, "Error getting transactions")
+	}
+	page := model.TransactionPage{Total: total}
+	if limit > 0 && int64(len(transactions)) > limit {
+		transactions = transactions[:limit]
+		last := transactions[len(transactions)-1]
+		page.NextCursor = encodeCursor(cursor{SortBy: sortBy, Value: sortValue(key, last), ID: last.ID})
 	}
+	page.Transactions = transactions
 	if len(transactions) == 0 {
-		return []model.Transaction{}, "No transactions found", nil
+		page.Transactions = []model.Transaction{}
+		return page, "No transactions found", nil
+	}
+	return page, "success", nil
+}
+
+func (d *DatabaseRepo) SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, string, error) {
+	if b.ID == 0 {
+		query := `INSERT INTO budgets (user_id, category, monthly_limit) VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, category) DO UPDATE SET monthly_limit = EXCLUDED.monthly_limit RETURNING id`
+		var id int64
+		if err := d.db.QueryRowContext(ctx, query, uid, b.Kategoria, b.Limit).Scan(&id); err != nil {
+			return 0, "failed to set budget", apperror.SystemError(err, 1101, "Failed to set budget")
+		}
+		return id, "success", nil
+	}
+	query := `UPDATE budgets SET category = $1, monthly_limit = $2 WHERE id = $3 AND user_id = $4`
+	res, err := d.db.ExecContext(ctx, query, b.Kategoria, b.Limit, b.ID, uid)
+	if isPQError(err, "23505") {
+		return 0, "Budget already exists", apperror.CustomError(err, http.StatusConflict, 1240, "Category already has a budget")
+	}
+	if err != nil {
+		return 0, "failed to set budget", apperror.SystemError(err, 1102, "Failed to update budget")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, "failed to set budget", apperror.SystemError(err, 1103, "Error getting rows affected")
+	}
+	if rows == 0 {
+		mes, err := missingRow(ctx, d.db, "budgets", "Budget", b.ID, uid, apperror.NotFoundError(errors.New("budget not found"), 1104, "Budget not found"), 1251)
+		return 0, mes, err
+	}
+	return b.ID, "success", nil
+}
+
+func (d *DatabaseRepo) DeleteBudget(ctx context.Context, uid, bid int64) (string, error) {
+	query := `DELETE FROM budgets WHERE id = $1 AND user_id = $2`
+	res, err := d.db.ExecContext(ctx, query, bid, uid)
+	if err != nil {
+		return "failed to delete budget", apperror.SystemError(err, 1105, "Failed to delete budget")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to delete budget", apperror.SystemError(err, 1106, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "budgets", "Budget", bid, uid, apperror.NotFoundError(errors.New("budget not found"), 1107, "Budget not found"), 1251)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, string, error) {
+	spent := convertedAmount("$6", "0")
+	query := `SELECT b.id, b.category, b.monthly_limit, COALESCE(SUM(` + spent + `), 0), COALESCE(SUM(` + spent + `) FILTER (WHERE t.id = $7), 0)
+		FROM budgets b
+		LEFT JOIN transactions t ON t.user_id = b.user_id AND t.category = b.category
+			AND t.type = $2 AND t.date >= $3 AND t.date < $4 AND t.transfer_id IS NULL
+		WHERE b.user_id = $1 AND (COALESCE(cardinality($5::text[]), 0) = 0 OR b.category = ANY($5))
+		GROUP BY b.id, b.category, b.monthly_limit
+		ORDER BY b.category`
+	rows, err := d.db.QueryContext(ctx, query, uid, model.TypeExpense, periodStart, periodEnd, pq.Array(kategorias), model.BudgetCurrency, tid)
+	if err != nil {
+		return []model.Budget{}, "failed to get budgets", apperror.SystemError(err, 1108, "Failed to get budgets")
+	}
+	defer rows.Close()
+	budgets := []model.Budget{}
+	for rows.Next() {
+		var b model.Budget
+		if err := rows.Scan(&b.ID, &b.Kategoria, &b.Limit, &b.Spent, &b.Added); err != nil {
+			return []model.Budget{}, "failed to get budgets", apperror.SystemError(err, 1109, "Error scanning budget")
+		}
+		budgets = append(budgets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.Budget{}, "failed to get budgets", apperror.SystemError(err, 1110, "Error getting budgets")
 	}
-	return transactions, "success", nil
+	return budgets, "success", nil
 }
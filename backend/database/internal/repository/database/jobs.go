@@ -0,0 +1,116 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// ClaimCategorizationJobs leases up to limit due jobs until leaseUntil and
+// counts the attempt. Jobs locked by another claimer are skipped, so
+// concurrent workers never get the same job.
+func (d *DatabaseRepo) ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, string, error) {
+	query := `UPDATE categorization_jobs j SET status = 'running', attempts = j.attempts + 1, run_at = $2, updated_at = $1
+		FROM transactions t
+		WHERE t.id = j.transaction_id AND j.id IN (
+			SELECT id FROM categorization_jobs
+			WHERE status <> 'failed' AND run_at <= $1
+			ORDER BY run_at, id LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING j.id, j.user_id, j.transaction_id, j.attempts, t.date, t.category, t.type, t.amount, t.description, t.account_id, t.currency`
+	rows, err := d.db.QueryContext(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		return []model.CategorizationJob{}, "failed to claim categorization jobs", apperror.SystemError(err, 1217, "Failed to claim categorization jobs")
+	}
+	defer rows.Close()
+	jobs := []model.CategorizationJob{}
+	for rows.Next() {
+		var j model.CategorizationJob
+		t := &j.Transaction
+		err := rows.Scan(&j.ID, &j.UserID, &j.TransactionID, &j.Attempts, &t.Date, &t.Kategoria, &t.Type, &t.Amount, &t.Description, &t.AccountID, &t.Currency)
+		if err != nil {
+			return []model.CategorizationJob{}, "failed to claim categorization jobs", apperror.SystemError(err, 1218, "Error scanning categorization job")
+		}
+		t.ID = j.TransactionID
+		t.CategoryPending = true
+		jobs = append(jobs, j)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.CategorizationJob{}, "failed to claim categorization jobs", apperror.SystemError(err, 1219, "Error getting categorization jobs")
+	}
+	return jobs, "success", nil
+}
+
+// CompleteCategorizationJob removes the job and sets the category of its
+// transaction. attempts fences off a worker whose lease has expired and whose
+// job was claimed again. Applied is false when the job was no longer held or
+// the user categorised the transaction in the meantime.
+func (d *DatabaseRepo) CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, "failed to complete categorization job", apperror.SystemError(err, 1220, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	var tid int64
+	query := `DELETE FROM categorization_jobs WHERE id = $1 AND attempts = $2 AND status = 'running' RETURNING transaction_id`
+	err = tx.QueryRowContext(ctx, query, id, attempts).Scan(&tid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, "job is no longer held", nil
+	}
+	if err != nil {
+		return false, "failed to complete categorization job", apperror.SystemError(err, 1221, "Failed to complete categorization job")
+	}
+	query = `UPDATE transactions SET category = $1, category_pending = FALSE WHERE id = $2 AND category_pending`
+	res, err := tx.ExecContext(ctx, query, kategoria, tid)
+	if err != nil {
+		return false, "failed to complete categorization job", apperror.SystemError(err, 1222, "Failed to set transaction category")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, "failed to complete categorization job", apperror.SystemError(err, 1223, "Error getting rows affected")
+	}
+	if err := tx.Commit(); err != nil {
+		return false, "failed to complete categorization job", apperror.SystemError(err, 1224, "Failed to commit transaction")
+	}
+	return rows > 0, "success", nil
+}
+
+// FailCategorizationJob records a failed attempt. The job is retried at
+// retryAt unless final is set; then it is kept as failed and its transaction
+// stops being pending with whatever category it has.
+func (d *DatabaseRepo) FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) (string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "failed to fail categorization job", apperror.SystemError(err, 1225, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	status := "pending"
+	if final {
+		status = "failed"
+	}
+	var tid int64
+	query := `UPDATE categorization_jobs SET status = $1, run_at = $2, last_error = $3, updated_at = $4
+		WHERE id = $5 AND attempts = $6 AND status = 'running' RETURNING transaction_id`
+	err = tx.QueryRowContext(ctx, query, status, retryAt, errMes, time.Now().UnixMilli(), id, attempts).Scan(&tid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "job is no longer held", nil
+	}
+	if err != nil {
+		return "failed to fail categorization job", apperror.SystemError(err, 1226, "Failed to update categorization job")
+	}
+	if final {
+		query = `UPDATE transactions SET category_pending = FALSE WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, tid); err != nil {
+			return "failed to fail categorization job", apperror.SystemError(err, 1227, "Failed to update transaction")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return "failed to fail categorization job", apperror.SystemError(err, 1228, "Failed to commit transaction")
+	}
+	return "success", nil
+}
@@ -0,0 +1,170 @@
+package dbrepo
+
+import (
+	model "database/internal/models"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/lib/pq"
+)
+
+const (
+	defaultSortBy = "date_desc"
+	maxPageLimit  = 500
+	// ratesTimezone is the zone of the exchange rate dates: the Central Bank
+	// of Russia sets its rates per Moscow calendar day.
+	ratesTimezone = "Europe/Moscow"
+)
+
+type sortKey struct {
+	column string
+	desc   bool
+}
+
+var sortKeys = map[string]sortKey{
+	"date_desc":   {column: "date", desc: true},
+	"date_asc":    {column: "date", desc: false},
+	"amount_desc": {column: "amount", desc: true},
+	"amount_asc":  {column: "amount", desc: false},
+}
+
+// cursor points at the last row of the previous page: the value of the sort
+// column and the row id used as a tie-breaker.
+type cursor struct {
+	SortBy string `json:"s"`
+	Value  int64  `json:"v"`
+	ID     int64  `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// transactionWhere collects the WHERE conditions and their positional args.
+type transactionWhere struct {
+	conds []string
+	args  []any
+}
+
+func (w *transactionWhere) add(cond string, args ...any) {
+	for _, a := range args {
+		w.args = append(w.args, a)
+		cond = strings.Replace(cond, "?", fmt.Sprintf("$%d", len(w.args)), 1)
+	}
+	w.conds = append(w.conds, cond)
+}
+
+// arg appends a positional arg that is used outside the WHERE clause and
+// returns its placeholder.
+func (w *transactionWhere) arg(v any) string {
+	w.args = append(w.args, v)
+	return fmt.Sprintf("$%d", len(w.args))
+}
+
+func (w *transactionWhere) String() string {
+	return strings.Join(w.conds, " AND ")
+}
+
+func buildTransactionWhere(uid int64, q model.TransactionQuery) *transactionWhere {
+	w := &transactionWhere{}
+	w.add("user_id = ?", uid)
+	if q.DateFrom != 0 {
+		w.add("date >= ?", q.DateFrom)
+	}
+	if q.DateTo != 0 {
+		w.add("date <= ?", q.DateTo)
+	}
+	if len(q.Kategorias) > 0 {
+		w.add("category = ANY(?)", pq.Array(q.Kategorias))
+	}
+	if q.Type != "" {
+		w.add("type = ?", q.Type)
+	}
+	if q.AmountMin != nil {
+		w.add("amount >= ?", *q.AmountMin)
+	}
+	if q.AmountMax != nil {
+		w.add("amount <= ?", *q.AmountMax)
+	}
+	if q.Description != "" {
+		w.add(`description ILIKE '%' || ? || '%' ESCAPE '\'`, escapeLike(q.Description))
+	}
+	if q.AccountID != 0 {
+		w.add("account_id = ?", q.AccountID)
+	}
+	return w
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func resolveSort(sortBy string) (string, sortKey, error) {
+	if sortBy == "" {
+		sortBy = defaultSortBy
+	}
+	key, ok := sortKeys[sortBy]
+	if !ok {
+		return "", sortKey{}, apperror.BadRequestError(fmt.Errorf("unknown sort key %q", sortBy), 1095, "Unknown sort key")
+	}
+	return sortBy, key, nil
+}
+
+func applyCursor(w *transactionWhere, sortBy string, key sortKey, raw string) error {
+	if raw == "" {
+		return nil
+	}
+	c, err := decodeCursor(raw)
+	if err != nil {
+		return apperror.BadRequestError(err, 1096, "Invalid cursor")
+	}
+	if c.SortBy != sortBy {
+		return apperror.BadRequestError(errors.New("cursor sort key mismatch"), 1097, "Cursor does not match sort order")
+	}
+	op := ">"
+	if key.desc {
+		op = "<"
+	}
+	w.add(fmt.Sprintf("(%s, id) %s (?, ?)", key.column, op), c.Value, c.ID)
+	return nil
+}
+
+func orderClause(key sortKey) string {
+	dir := "ASC"
+	if key.desc {
+		dir = "DESC"
+	}
+	return fmt.Sprintf("ORDER BY %s %s, id %s", key.column, dir, dir)
+}
+
+func sortValue(key sortKey, t model.Transaction) int64 {
+	if key.column == "amount" {
+		return t.Amount
+	}
+	return t.Date
+}
+
+// convertedAmount converts amount from the row currency into base through the
+// rouble rates of rateDate, or of the transaction date when rateDate is 0.
+// Both arguments are placeholders. The result is NULL when a rate is missing.
+func convertedAmount(base, rateDate string) string {
+	day := `(to_timestamp(COALESCE(NULLIF(` + rateDate + `::bigint, 0), date) / 1000.0) AT TIME ZONE '` + ratesTimezone + `')::date`
+	return `ROUND(amount * rub_rate(currency, ` + day + `) / rub_rate(` + base + `::text, ` + day + `))::bigint`
+}
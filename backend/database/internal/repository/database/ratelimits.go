@@ -0,0 +1,50 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// RequestRateLimit returns the counter of key for the user, with Version 0
+// when there is none yet.
+func (d *DatabaseRepo) RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, string, error) {
+	var s model.RateLimitState
+	query := `SELECT tokens, updated_at, day, used, version FROM rate_limits WHERE user_id = $1 AND key = $2`
+	err := d.db.QueryRowContext(ctx, query, uid, key).Scan(&s.Tokens, &s.UpdatedAt, &s.Day, &s.Used, &s.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.RateLimitState{}, "No rate limit found", nil
+	}
+	if err != nil {
+		return model.RateLimitState{}, "failed to get rate limit", apperror.SystemError(err, 1235, "Failed to get rate limit")
+	}
+	return s, "success", nil
+}
+
+// SwapRateLimit stores s as the counter of key for the user if the stored
+// counter is still at s.Version, the version s was computed from. It returns
+// false when another request wrote the counter in between.
+func (d *DatabaseRepo) SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, string, error) {
+	query := `UPDATE rate_limits SET tokens = $1, updated_at = $2, day = $3, used = $4, version = version + 1
+		WHERE user_id = $5 AND key = $6 AND version = $7`
+	if s.Version == 0 {
+		query = `INSERT INTO rate_limits (tokens, updated_at, day, used, user_id, key) VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (user_id, key) DO NOTHING`
+	}
+	args := []any{s.Tokens, s.UpdatedAt, s.Day, s.Used, uid, key}
+	if s.Version != 0 {
+		args = append(args, s.Version)
+	}
+	res, err := d.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, "failed to update rate limit", apperror.SystemError(err, 1236, "Failed to update rate limit")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, "failed to update rate limit", apperror.SystemError(err, 1237, "Error getting rows affected")
+	}
+	return rows > 0, "success", nil
+}
@@ -0,0 +1,34 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// SaveExchangeRates upserts the rates, so loading the same file twice or a
+// corrected file replaces earlier values.
+func (d *DatabaseRepo) SaveExchangeRates(ctx context.Context, rates []model.ExchangeRate) (int64, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "failed to save exchange rates", apperror.SystemError(err, 1167, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	query := `INSERT INTO exchange_rates (currency, date, rate) VALUES ($1, $2, $3::numeric / $4)
+		ON CONFLICT (currency, date) DO UPDATE SET rate = EXCLUDED.rate`
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, "failed to save exchange rates", apperror.SystemError(err, 1168, "Failed to prepare insert")
+	}
+	defer stmt.Close()
+	for _, r := range rates {
+		if _, err := stmt.ExecContext(ctx, r.Currency, r.Date, r.Value, r.Nominal); err != nil {
+			return 0, "failed to save exchange rates", apperror.SystemError(err, 1169, "Failed to save exchange rate")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, "failed to save exchange rates", apperror.SystemError(err, 1170, "Failed to commit exchange rates")
+	}
+	return int64(len(rates)), "success", nil
+}
@@ -0,0 +1,61 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// StartRecategorization replaces the user's previous re-categorisation with
+// a new one unless that one is still running or applying. A job that has not
+// been updated since staleBefore is taken as abandoned by its replica and
+// replaced as well. It returns the id of the new job, or false when another
+// one is in progress.
+func (d *DatabaseRepo) StartRecategorization(ctx context.Context, uid int64, r model.Recategorization, now, staleBefore int64) (int64, bool, string, error) {
+	query := `INSERT INTO recategorizations (user_id, status, data, updated_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET id = nextval('recategorizations_id_seq'), status = EXCLUDED.status, data = EXCLUDED.data, updated_at = EXCLUDED.updated_at
+		WHERE recategorizations.status NOT IN ('running', 'applying') OR recategorizations.updated_at < $5
+		RETURNING id`
+	var id int64
+	err := d.db.QueryRowContext(ctx, query, uid, r.Status, r.Data, now, staleBefore).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, "recategorization in progress", nil
+	}
+	if err != nil {
+		return 0, false, "failed to start recategorization", apperror.SystemError(err, 1246, "Failed to start recategorization")
+	}
+	return id, true, "success", nil
+}
+
+// UpdateRecategorization saves the state of job r.ID. With fromStatus set it
+// only does so while the job is in that status, so two replicas cannot both
+// apply the same dry run.
+func (d *DatabaseRepo) UpdateRecategorization(ctx context.Context, uid int64, r model.Recategorization, fromStatus string, now int64) (bool, string, error) {
+	query := `UPDATE recategorizations SET status = $1, data = $2, updated_at = $3
+		WHERE user_id = $4 AND id = $5 AND ($6 = '' OR status = $6)`
+	res, err := d.db.ExecContext(ctx, query, r.Status, r.Data, now, uid, r.ID, fromStatus)
+	if err != nil {
+		return false, "failed to update recategorization", apperror.SystemError(err, 1247, "Failed to update recategorization")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, "failed to update recategorization", apperror.SystemError(err, 1248, "Error getting rows affected")
+	}
+	return rows > 0, "success", nil
+}
+
+func (d *DatabaseRepo) RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, string, error) {
+	var r model.Recategorization
+	query := `SELECT id, status, data FROM recategorizations WHERE user_id = $1`
+	err := d.db.QueryRowContext(ctx, query, uid).Scan(&r.ID, &r.Status, &r.Data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Recategorization{}, false, "No recategorization found", nil
+	}
+	if err != nil {
+		return model.Recategorization{}, false, "failed to get recategorization", apperror.SystemError(err, 1249, "Failed to get recategorization")
+	}
+	return r, true, "success", nil
+}
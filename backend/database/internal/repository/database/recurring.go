@@ -0,0 +1,143 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+	"errors"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+const recurringColumns = `id, user_id, category, type, amount, description, frequency, start_date, end_date, next_date, runs, paused`
+
+func (d *DatabaseRepo) AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, string, error) {
+	query := `INSERT INTO recurring_transactions (user_id, category, type, amount, description, frequency, start_date, end_date, next_date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $7) RETURNING id`
+	var id int64
+	err := d.db.QueryRowContext(ctx, query, uid, r.Kategoria, r.Type, r.Amount, r.Description, r.Frequency, r.StartDate, r.EndDate).Scan(&id)
+	if err != nil {
+		return 0, "failed to add recurring transaction", apperror.SystemError(err, 1111, "Failed to add recurring transaction")
+	}
+	return id, "success", nil
+}
+
+func (d *DatabaseRepo) RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, string, error) {
+	query := `SELECT ` + recurringColumns + ` FROM recurring_transactions WHERE user_id = $1 ORDER BY next_date`
+	rows, err := d.db.QueryContext(ctx, query, uid)
+	if err != nil {
+		return []model.Recurring{}, "failed to get recurring transactions", apperror.SystemError(err, 1112, "Failed to get recurring transactions")
+	}
+	return scanRecurring(rows)
+}
+
+func (d *DatabaseRepo) SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) (string, error) {
+	query := `UPDATE recurring_transactions SET paused = $1 WHERE id = $2 AND user_id = $3`
+	res, err := d.db.ExecContext(ctx, query, paused, rid, uid)
+	if err != nil {
+		return "failed to update recurring transaction", apperror.SystemError(err, 1113, "Failed to update recurring transaction")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to update recurring transaction", apperror.SystemError(err, 1114, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "recurring_transactions", "Recurring transaction", rid, uid, apperror.NotFoundError(errors.New("recurring transaction not found"), 1115, "Recurring transaction not found"), 1252)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) DeleteRecurring(ctx context.Context, uid, rid int64) (string, error) {
+	query := `DELETE FROM recurring_transactions WHERE id = $1 AND user_id = $2`
+	res, err := d.db.ExecContext(ctx, query, rid, uid)
+	if err != nil {
+		return "failed to delete recurring transaction", apperror.SystemError(err, 1116, "Failed to delete recurring transaction")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to delete recurring transaction", apperror.SystemError(err, 1117, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "recurring_transactions", "Recurring transaction", rid, uid, apperror.NotFoundError(errors.New("recurring transaction not found"), 1118, "Recurring transaction not found"), 1252)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, string, error) {
+	query := `SELECT ` + recurringColumns + ` FROM recurring_transactions
+		WHERE NOT paused AND next_date <= $1 AND (end_date = 0 OR next_date <= end_date)
+		ORDER BY next_date LIMIT $2`
+	rows, err := d.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return []model.Recurring{}, "failed to get due recurring transactions", apperror.SystemError(err, 1119, "Failed to get due recurring transactions")
+	}
+	return scanRecurring(rows)
+}
+
+// AdvanceRecurring moves a template from runs to nextRuns only if nobody has
+// advanced it in the meantime, so each occurrence is claimed exactly once.
+func (d *DatabaseRepo) AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, string, error) {
+	query := `UPDATE recurring_transactions SET runs = $1, next_date = $2 WHERE id = $3 AND runs = $4`
+	res, err := d.db.ExecContext(ctx, query, nextRuns, nextDate, rid, runs)
+	if err != nil {
+		return false, "failed to advance recurring transaction", apperror.SystemError(err, 1120, "Failed to advance recurring transaction")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, "failed to advance recurring transaction", apperror.SystemError(err, 1121, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return false, "already advanced", nil
+	}
+	return true, "success", nil
+}
+
+// MaterializeRecurring claims occurrence runs of the template like
+// AdvanceRecurring, moving it to runs+1 and nextDate, and inserts t in the
+// same database transaction. Either both happen or neither, so a failed
+// insert leaves the occurrence due. It returns the id of the new transaction,
+// or false when the occurrence was already claimed.
+func (d *DatabaseRepo) MaterializeRecurring(ctx context.Context, uid, rid, runs, nextDate int64, t model.Transaction) (int64, bool, string, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, "failed to materialize recurring transaction", apperror.SystemError(err, 1241, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+	query := `UPDATE recurring_transactions SET runs = runs + 1, next_date = $1 WHERE id = $2 AND user_id = $3 AND runs = $4`
+	res, err := tx.ExecContext(ctx, query, nextDate, rid, uid, runs)
+	if err != nil {
+		return 0, false, "failed to materialize recurring transaction", apperror.SystemError(err, 1242, "Failed to advance recurring transaction")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, false, "failed to materialize recurring transaction", apperror.SystemError(err, 1243, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return 0, false, "already advanced", nil
+	}
+	id, err := insertTransaction(ctx, tx, uid, t, false)
+	if err != nil {
+		return 0, false, "failed to materialize recurring transaction", apperror.SystemError(err, 1244, "Failed to add transaction")
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, false, "failed to materialize recurring transaction", apperror.SystemError(err, 1245, "Failed to commit transaction")
+	}
+	return id, true, "success", nil
+}
+
+func scanRecurring(rows *sql.Rows) ([]model.Recurring, string, error) {
+	defer rows.Close()
+	recurring := []model.Recurring{}
+	for rows.Next() {
+		var r model.Recurring
+		err := rows.Scan(&r.ID, &r.UserID, &r.Kategoria, &r.Type, &r.Amount, &r.Description, &r.Frequency, &r.StartDate, &r.EndDate, &r.NextDate, &r.Runs, &r.Paused)
+		if err != nil {
+			return []model.Recurring{}, "failed to get recurring transactions", apperror.SystemError(err, 1122, "Error scanning recurring transaction")
+		}
+		recurring = append(recurring, r)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.Recurring{}, "failed to get recurring transactions", apperror.SystemError(err, 1123, "Error getting recurring transactions")
+	}
+	return recurring, "success", nil
+}
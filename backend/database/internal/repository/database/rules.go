@@ -0,0 +1,81 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"errors"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+func (d *DatabaseRepo) AddRule(ctx context.Context, uid int64, r model.Rule) (int64, string, error) {
+	query := `INSERT INTO categorization_rules (user_id, priority, description_contains, description_regex, amount_min, amount_max, type, category)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+	var id int64
+	err := d.db.QueryRowContext(ctx, query, uid, r.Priority, r.DescriptionContains, r.DescriptionRegex, r.AmountMin, r.AmountMax, r.Type, r.Kategoria).Scan(&id)
+	if err != nil {
+		return 0, "failed to add rule", apperror.SystemError(err, 1200, "Failed to add rule")
+	}
+	return id, "success", nil
+}
+
+// EditRule updates one of the user's own rules; global rules are read-only.
+func (d *DatabaseRepo) EditRule(ctx context.Context, uid int64, r model.Rule) (string, error) {
+	query := `UPDATE categorization_rules SET priority = $1, description_contains = $2, description_regex = $3,
+			amount_min = $4, amount_max = $5, type = $6, category = $7
+		WHERE id = $8 AND user_id = $9`
+	res, err := d.db.ExecContext(ctx, query, r.Priority, r.DescriptionContains, r.DescriptionRegex, r.AmountMin, r.AmountMax, r.Type, r.Kategoria, r.ID, uid)
+	if err != nil {
+		return "failed to edit rule", apperror.SystemError(err, 1202, "Failed to edit rule")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to edit rule", apperror.SystemError(err, 1203, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "categorization_rules", "Rule", r.ID, uid, apperror.NotFoundError(errors.New("rule not found"), 1204, "Rule not found"), 1255)
+	}
+	return "success", nil
+}
+
+func (d *DatabaseRepo) DeleteRule(ctx context.Context, uid, rid int64) (string, error) {
+	query := `DELETE FROM categorization_rules WHERE id = $1 AND user_id = $2`
+	res, err := d.db.ExecContext(ctx, query, rid, uid)
+	if err != nil {
+		return "failed to delete rule", apperror.SystemError(err, 1205, "Failed to delete rule")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return "failed to delete rule", apperror.SystemError(err, 1206, "Error getting rows affected")
+	}
+	if rows == 0 {
+		return missingRow(ctx, d.db, "categorization_rules", "Rule", rid, uid, apperror.NotFoundError(errors.New("rule not found"), 1204, "Rule not found"), 1255)
+	}
+	return "success", nil
+}
+
+// RequestUserRules returns the user's rules and the global ones in the order
+// they are evaluated: by priority, the user's own rules first on a tie.
+func (d *DatabaseRepo) RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, string, error) {
+	query := `SELECT id, priority, description_contains, description_regex, amount_min, amount_max, type, category, user_id IS NULL
+		FROM categorization_rules
+		WHERE user_id = $1 OR user_id IS NULL
+		ORDER BY priority DESC, user_id IS NULL, id`
+	rows, err := d.db.QueryContext(ctx, query, uid)
+	if err != nil {
+		return []model.Rule{}, "failed to get rules", apperror.SystemError(err, 1207, "Failed to get rules")
+	}
+	defer rows.Close()
+	rules := []model.Rule{}
+	for rows.Next() {
+		var r model.Rule
+		if err := rows.Scan(&r.ID, &r.Priority, &r.DescriptionContains, &r.DescriptionRegex, &r.AmountMin, &r.AmountMax, &r.Type, &r.Kategoria, &r.Global); err != nil {
+			return []model.Rule{}, "failed to get rules", apperror.SystemError(err, 1208, "Error scanning rule")
+		}
+		rules = append(rules, r)
+	}
+	if err := rows.Err(); err != nil {
+		return []model.Rule{}, "failed to get rules", apperror.SystemError(err, 1209, "Error getting rules")
+	}
+	return rules, "success", nil
+}
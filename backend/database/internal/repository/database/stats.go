@@ -0,0 +1,129 @@
+package dbrepo
+
+import (
+	"context"
+	model "database/internal/models"
+	"database/sql"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// RequestUserStats aggregates the user's transactions for the period. All
+// queries run in one read-only snapshot so the totals, categories and periods
+// always add up even while transactions are being added.
+func (d *DatabaseRepo) RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, string, error) {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1128, "Failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	stats := model.Stats{
+		Categories:      []model.CategoryTotal{},
+		Periods:         []model.PeriodTotal{},
+		TopDescriptions: []model.DescriptionTotal{},
+	}
+	period := model.TransactionQuery{DateFrom: q.DateFrom, DateTo: q.DateTo}
+
+	w := statsWhere(uid, period)
+	amount := statsAmount(w, q)
+	income, expense := w.arg(model.TypeIncome), w.arg(model.TypeExpense)
+	query := `SELECT COALESCE(SUM(` + amount + `) FILTER (WHERE type = ` + income + `), 0),
+			COALESCE(SUM(` + amount + `) FILTER (WHERE type = ` + expense + `), 0)
+		FROM transactions WHERE ` + w.String()
+	if err := tx.QueryRowContext(ctx, query, w.args...).Scan(&stats.Income, &stats.Expense); err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1129, "Failed to get totals")
+	}
+	stats.Net = stats.Income - stats.Expense
+
+	w = statsWhere(uid, period)
+	amount = statsAmount(w, q)
+	query = `SELECT category, type, COALESCE(SUM(` + amount + `), 0), COUNT(*) FROM transactions WHERE ` + w.String() + `
+		GROUP BY category, type ORDER BY type, 3 DESC, category`
+	rows, err := tx.QueryContext(ctx, query, w.args...)
+	if err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1130, "Failed to get category totals")
+	}
+	for rows.Next() {
+		var c model.CategoryTotal
+		if err := rows.Scan(&c.Kategoria, &c.Type, &c.Amount, &c.Count); err != nil {
+			rows.Close()
+			return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1131, "Error scanning category total")
+		}
+		stats.Categories = append(stats.Categories, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1132, "Error getting category totals")
+	}
+
+	// Buckets are truncated in the user's time zone so that a month or a day
+	// starts at local midnight, then converted back to epoch milliseconds.
+	w = statsWhere(uid, period)
+	amount = statsAmount(w, q)
+	income, expense = w.arg(model.TypeIncome), w.arg(model.TypeExpense)
+	granularity, tz := w.arg(q.Granularity), w.arg(q.Timezone)
+	query = `SELECT (EXTRACT(EPOCH FROM date_trunc(` + granularity + `::text, to_timestamp(date / 1000.0) AT TIME ZONE ` + tz + `::text) AT TIME ZONE ` + tz + `::text) * 1000)::bigint AS period,
+			COALESCE(SUM(` + amount + `) FILTER (WHERE type = ` + income + `), 0),
+			COALESCE(SUM(` + amount + `) FILTER (WHERE type = ` + expense + `), 0)
+		FROM transactions WHERE ` + w.String() + `
+		GROUP BY period ORDER BY period`
+	rows, err = tx.QueryContext(ctx, query, w.args...)
+	if err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1133, "Failed to get period totals")
+	}
+	for rows.Next() {
+		var p model.PeriodTotal
+		if err := rows.Scan(&p.PeriodStart, &p.Income, &p.Expense); err != nil {
+			rows.Close()
+			return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1134, "Error scanning period total")
+		}
+		p.Net = p.Income - p.Expense
+		stats.Periods = append(stats.Periods, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1135, "Error getting period totals")
+	}
+
+	// Descriptions that differ only in case or surrounding spaces are counted
+	// as the same merchant.
+	w = statsWhere(uid, model.TransactionQuery{DateFrom: q.DateFrom, DateTo: q.DateTo, Type: model.TypeExpense})
+	amount = statsAmount(w, q)
+	w.add("btrim(description) <> ''")
+	query = `SELECT MIN(btrim(description)), COALESCE(SUM(` + amount + `), 0), COUNT(*) FROM transactions WHERE ` + w.String() + `
+		GROUP BY lower(btrim(description)) ORDER BY 2 DESC, 3 DESC LIMIT ` + w.arg(q.TopN)
+	rows, err = tx.QueryContext(ctx, query, w.args...)
+	if err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1136, "Failed to get top descriptions")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var t model.DescriptionTotal
+		if err := rows.Scan(&t.Description, &t.Amount, &t.Count); err != nil {
+			return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1137, "Error scanning top description")
+		}
+		stats.TopDescriptions = append(stats.TopDescriptions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return model.Stats{}, "failed to get stats", apperror.SystemError(err, 1138, "Error getting top descriptions")
+	}
+	return stats, "success", nil
+}
+
+// statsWhere leaves transfers out: moving money between own accounts is
+// neither income nor spending.
+func statsWhere(uid int64, q model.TransactionQuery) *transactionWhere {
+	w := buildTransactionWhere(uid, q)
+	w.add("transfer_id IS NULL")
+	return w
+}
+
+// statsAmount is the amount column of a stats query: the raw amount, or the
+// amount in q.BaseCurrency. Rows without a known rate then add nothing.
+func statsAmount(w *transactionWhere, q model.StatsQuery) string {
+	if q.BaseCurrency == "" {
+		return "amount"
+	}
+	return convertedAmount(w.arg(q.BaseCurrency), w.arg(q.RateDate))
+}
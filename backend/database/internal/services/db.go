@@ -11,27 +11,61 @@ import (
 type Database struct {
 	log                     *logrus.Logger
 	addTransaction          AdderTransaction
+	addTransactions         AdderTransactions
 	addUser                 AdderUser
 	deleteTransaction       DeleterTransaction
 	deleteUser              DeleterUser
 	editTransaction         EditorTransaction
 	requestUserTransactions RequesterUserTransactions
+	setBudget               SetterBudget
+	deleteBudget            DeleterBudget
+	requestUserBudgets      RequesterUserBudgets
+	recurring               RecurringManager
+	requestUserStats        RequesterUserStats
+	accounts                AccountManager
+	saveExchangeRates       SaverExchangeRates
+	chat                    ChatManager
+	categories              CategoryManager
+	rules                   RuleManager
+	corrections             CorrectionProvider
+	categorizationJobs      CategorizationJobManager
+	rateLimits              RateLimiter
+	recategorizations       RecategorizationStore
 }
 
 func New(log *logrus.Logger, db *dbrepo.DatabaseRepo) *Database {
 	return &Database{
 		log:                     log,
 		addTransaction:          db,
+		addTransactions:         db,
 		addUser:                 db,
 		deleteTransaction:       db,
 		deleteUser:              db,
 		editTransaction:         db,
 		requestUserTransactions: db,
+		setBudget:               db,
+		deleteBudget:            db,
+		requestUserBudgets:      db,
+		recurring:               db,
+		requestUserStats:        db,
+		accounts:                db,
+		saveExchangeRates:       db,
+		chat:                    db,
+		categories:              db,
+		rules:                   db,
+		corrections:             db,
+		categorizationJobs:      db,
+		rateLimits:              db,
+		recategorizations:       db,
 	}
 }
 
 type AdderTransaction interface {
-	AddTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error)
+	AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, string, error)
+}
+
+type AdderTransactions interface {
+	AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, string, error)
 }
 
 type AdderUser interface {
@@ -47,15 +81,99 @@ type DeleterUser interface {
 }
 
 type EditorTransaction interface {
-	EditTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error)
+	EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, string, error)
+	RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, string, error)
 }
 
 type RequesterUserTransactions interface {
-	RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, string, error)
+	RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, string, error)
+}
+
+type SetterBudget interface {
+	SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, string, error)
+}
+
+type DeleterBudget interface {
+	DeleteBudget(ctx context.Context, uid, bid int64) (string, error)
+}
+
+type RequesterUserBudgets interface {
+	RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, string, error)
+}
+
+type RecurringManager interface {
+	AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, string, error)
+	RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, string, error)
+	SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) (string, error)
+	DeleteRecurring(ctx context.Context, uid, rid int64) (string, error)
+	RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, string, error)
+	AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, string, error)
+	MaterializeRecurring(ctx context.Context, uid, rid, runs, nextDate int64, t model.Transaction) (int64, bool, string, error)
+}
+
+type RequesterUserStats interface {
+	RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, string, error)
+}
+
+type AccountManager interface {
+	AddAccount(ctx context.Context, uid int64, a model.Account) (int64, string, error)
+	EditAccount(ctx context.Context, uid int64, a model.Account) (string, error)
+	DeleteAccount(ctx context.Context, uid, aid int64) (string, error)
+	RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, string, error)
+	Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, string, error)
+}
+
+type SaverExchangeRates interface {
+	SaveExchangeRates(ctx context.Context, rates []model.ExchangeRate) (int64, string, error)
+}
+
+type CategoryManager interface {
+	AddCategory(ctx context.Context, uid int64, c model.Category) (int64, string, error)
+	EditCategory(ctx context.Context, uid int64, c model.Category) (string, error)
+	DeleteCategory(ctx context.Context, uid, cid int64) (string, error)
+	RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, string, error)
+}
+
+type RuleManager interface {
+	AddRule(ctx context.Context, uid int64, r model.Rule) (int64, string, error)
+	EditRule(ctx context.Context, uid int64, r model.Rule) (string, error)
+	DeleteRule(ctx context.Context, uid, rid int64) (string, error)
+	RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, string, error)
+}
+
+type CorrectionProvider interface {
+	RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, string, error)
+}
+
+type CategorizationJobManager interface {
+	ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, string, error)
+	CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, string, error)
+	FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) (string, error)
+}
+
+type RateLimiter interface {
+	RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, string, error)
+	SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, string, error)
+}
+
+type RecategorizationStore interface {
+	StartRecategorization(ctx context.Context, uid int64, r model.Recategorization, now, staleBefore int64) (int64, bool, string, error)
+	UpdateRecategorization(ctx context.Context, uid int64, r model.Recategorization, fromStatus string, now int64) (bool, string, error)
+	RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, string, error)
+}
+
+type ChatManager interface {
+	AddChatMessages(ctx context.Context, uid int64, messages []model.ChatMessage) (string, error)
+	RequestChatMessages(ctx context.Context, uid, limit, before int64) ([]model.ChatMessage, string, error)
+	ClearChatMessages(ctx context.Context, uid int64) (string, error)
 }
 
-func (d *Database) AddTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error) {
-	return d.addTransaction.AddTransaction(ctx, uid, t)
+func (d *Database) AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, string, error) {
+	return d.addTransaction.AddTransaction(ctx, uid, t, categorize)
+}
+
+func (d *Database) AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, string, error) {
+	return d.addTransactions.AddTransactions(ctx, uid, ts, skipDuplicates)
 }
 
 func (d *Database) AddUser(ctx context.Context, uid int64) (string, error) {
@@ -70,10 +188,162 @@ func (d *Database) DeleteUser(ctx context.Context, uid int64) (string, error) {
 	return d.deleteUser.DeleteUser(ctx, uid)
 }
 
-func (d *Database) EditTransaction(ctx context.Context, uid int64, t model.Transaction) (string, error) {
+func (d *Database) EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, string, error) {
 	return d.editTransaction.EditTransaction(ctx, uid, t)
 }
 
-func (d *Database) RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, string, error) {
-	return d.requestUserTransactions.RequestUserTransactions(ctx, uid)
+func (d *Database) RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, string, error) {
+	return d.editTransaction.RecategorizeTransaction(ctx, uid, tid, previous, kategoria)
+}
+
+func (d *Database) RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, string, error) {
+	return d.requestUserTransactions.RequestUserTransactions(ctx, uid, q)
+}
+
+func (d *Database) SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, string, error) {
+	return d.setBudget.SetBudget(ctx, uid, b)
+}
+
+func (d *Database) DeleteBudget(ctx context.Context, uid, bid int64) (string, error) {
+	return d.deleteBudget.DeleteBudget(ctx, uid, bid)
+}
+
+func (d *Database) RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, string, error) {
+	return d.requestUserBudgets.RequestUserBudgets(ctx, uid, periodStart, periodEnd, kategorias, tid)
+}
+
+func (d *Database) AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, string, error) {
+	return d.recurring.AddRecurring(ctx, uid, r)
+}
+
+func (d *Database) RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, string, error) {
+	return d.recurring.RequestUserRecurring(ctx, uid)
+}
+
+func (d *Database) SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) (string, error) {
+	return d.recurring.SetRecurringPaused(ctx, uid, rid, paused)
+}
+
+func (d *Database) DeleteRecurring(ctx context.Context, uid, rid int64) (string, error) {
+	return d.recurring.DeleteRecurring(ctx, uid, rid)
+}
+
+func (d *Database) RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, string, error) {
+	return d.recurring.RequestDueRecurring(ctx, before, limit)
+}
+
+func (d *Database) AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, string, error) {
+	return d.recurring.AdvanceRecurring(ctx, rid, runs, nextRuns, nextDate)
+}
+
+func (d *Database) MaterializeRecurring(ctx context.Context, uid, rid, runs, nextDate int64, t model.Transaction) (int64, bool, string, error) {
+	return d.recurring.MaterializeRecurring(ctx, uid, rid, runs, nextDate, t)
+}
+
+func (d *Database) RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, string, error) {
+	return d.requestUserStats.RequestUserStats(ctx, uid, q)
+}
+
+func (d *Database) AddAccount(ctx context.Context, uid int64, a model.Account) (int64, string, error) {
+	return d.accounts.AddAccount(ctx, uid, a)
+}
+
+func (d *Database) EditAccount(ctx context.Context, uid int64, a model.Account) (string, error) {
+	return d.accounts.EditAccount(ctx, uid, a)
+}
+
+func (d *Database) DeleteAccount(ctx context.Context, uid, aid int64) (string, error) {
+	return d.accounts.DeleteAccount(ctx, uid, aid)
+}
+
+func (d *Database) RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, string, error) {
+	return d.accounts.RequestUserAccounts(ctx, uid)
+}
+
+func (d *Database) Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, string, error) {
+	return d.accounts.Transfer(ctx, uid, t)
+}
+
+func (d *Database) SaveExchangeRates(ctx context.Context, rates []model.ExchangeRate) (int64, string, error) {
+	return d.saveExchangeRates.SaveExchangeRates(ctx, rates)
+}
+
+func (d *Database) AddChatMessages(ctx context.Context, uid int64, messages []model.ChatMessage) (string, error) {
+	return d.chat.AddChatMessages(ctx, uid, messages)
+}
+
+func (d *Database) RequestChatMessages(ctx context.Context, uid, limit, before int64) ([]model.ChatMessage, string, error) {
+	return d.chat.RequestChatMessages(ctx, uid, limit, before)
+}
+
+func (d *Database) ClearChatMessages(ctx context.Context, uid int64) (string, error) {
+	return d.chat.ClearChatMessages(ctx, uid)
+}
+
+func (d *Database) AddCategory(ctx context.Context, uid int64, c model.Category) (int64, string, error) {
+	return d.categories.AddCategory(ctx, uid, c)
+}
+
+func (d *Database) EditCategory(ctx context.Context, uid int64, c model.Category) (string, error) {
+	return d.categories.EditCategory(ctx, uid, c)
+}
+
+func (d *Database) DeleteCategory(ctx context.Context, uid, cid int64) (string, error) {
+	return d.categories.DeleteCategory(ctx, uid, cid)
+}
+
+func (d *Database) RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, string, error) {
+	return d.categories.RequestUserCategories(ctx, uid)
+}
+
+func (d *Database) AddRule(ctx context.Context, uid int64, r model.Rule) (int64, string, error) {
+	return d.rules.AddRule(ctx, uid, r)
+}
+
+func (d *Database) EditRule(ctx context.Context, uid int64, r model.Rule) (string, error) {
+	return d.rules.EditRule(ctx, uid, r)
+}
+
+func (d *Database) DeleteRule(ctx context.Context, uid, rid int64) (string, error) {
+	return d.rules.DeleteRule(ctx, uid, rid)
+}
+
+func (d *Database) RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, string, error) {
+	return d.rules.RequestUserRules(ctx, uid)
+}
+
+func (d *Database) RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, string, error) {
+	return d.corrections.RequestCategoryCorrections(ctx, uid, limit)
+}
+
+func (d *Database) ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, string, error) {
+	return d.categorizationJobs.ClaimCategorizationJobs(ctx, now, leaseUntil, limit)
+}
+
+func (d *Database) CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, string, error) {
+	return d.categorizationJobs.CompleteCategorizationJob(ctx, id, attempts, kategoria)
+}
+
+func (d *Database) FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) (string, error) {
+	return d.categorizationJobs.FailCategorizationJob(ctx, id, attempts, errMes, retryAt, final)
+}
+
+func (d *Database) RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, string, error) {
+	return d.rateLimits.RequestRateLimit(ctx, uid, key)
+}
+
+func (d *Database) SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, string, error) {
+	return d.rateLimits.SwapRateLimit(ctx, uid, key, s)
+}
+
+func (d *Database) StartRecategorization(ctx context.Context, uid int64, r model.Recategorization, now, staleBefore int64) (int64, bool, string, error) {
+	return d.recategorizations.StartRecategorization(ctx, uid, r, now, staleBefore)
+}
+
+func (d *Database) UpdateRecategorization(ctx context.Context, uid int64, r model.Recategorization, fromStatus string, now int64) (bool, string, error) {
+	return d.recategorizations.UpdateRecategorization(ctx, uid, r, fromStatus, now)
+}
+
+func (d *Database) RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, string, error) {
+	return d.recategorizations.RequestRecategorization(ctx, uid)
 }
@@ -0,0 +1,16 @@
+-- Category changes made by users, kept as labelled examples for categorisation.
+CREATE TABLE IF NOT EXISTS category_corrections (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    transaction_id BIGINT,
+    description TEXT NOT NULL,
+    type TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    old_category TEXT NOT NULL,
+    new_category TEXT NOT NULL,
+    created_at BIGINT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_corrections_user_id ON category_corrections(user_id, id DESC);
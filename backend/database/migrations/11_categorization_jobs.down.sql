@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS categorization_jobs;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_pending;
@@ -0,0 +1,22 @@
+ALTER TABLE transactions ADD COLUMN category_pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Categorization queue. A running job whose run_at (the end of its lease) has
+-- passed is claimed again, so jobs of a crashed worker are not lost. Finished
+-- jobs are deleted; failed ones are kept with their last error.
+CREATE TABLE IF NOT EXISTS categorization_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    transaction_id BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts BIGINT NOT NULL DEFAULT 0,
+    run_at BIGINT NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT uq_categorization_jobs_transaction UNIQUE (transaction_id),
+    CONSTRAINT chk_categorization_jobs_status CHECK (status IN ('pending', 'running', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_jobs_run_at ON categorization_jobs(run_at) WHERE status <> 'failed';
@@ -0,0 +1,13 @@
+-- Rate limit state of the manager, one row per user and limited endpoint.
+-- tokens is the token bucket as of updated_at; used counts the requests of
+-- the day starting at day (epoch milliseconds, UTC).
+CREATE TABLE IF NOT EXISTS rate_limits (
+    user_id BIGINT NOT NULL,
+    key TEXT NOT NULL,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at BIGINT NOT NULL,
+    day BIGINT NOT NULL,
+    used BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, key),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
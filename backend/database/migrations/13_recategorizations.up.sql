@@ -0,0 +1,12 @@
+-- Latest re-categorisation of every user, kept so that any manager replica
+-- can report it and apply a dry run. data is the job as the manager encodes
+-- it; status is copied out of it for the conditional updates.
+CREATE TABLE IF NOT EXISTS recategorizations (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    data JSONB NOT NULL,
+    updated_at BIGINT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_recategorizations_user UNIQUE (user_id)
+);
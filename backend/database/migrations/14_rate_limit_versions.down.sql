@@ -0,0 +1 @@
+ALTER TABLE rate_limits DROP COLUMN IF EXISTS version;
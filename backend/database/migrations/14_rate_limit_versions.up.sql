@@ -0,0 +1,3 @@
+-- The manager now decides on the counters itself and writes them back only
+-- if nobody else did in between; version tells it whether that happened.
+ALTER TABLE rate_limits ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_user_amount;
+DROP INDEX IF EXISTS idx_transactions_user_date;
\ No newline at end of file
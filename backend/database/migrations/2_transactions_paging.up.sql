@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_transactions_user_date ON transactions(user_id, date DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount ON transactions(user_id, amount DESC, id DESC);
\ No newline at end of file
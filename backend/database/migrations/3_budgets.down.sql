@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_user_category_date;
+DROP TABLE IF EXISTS budgets;
\ No newline at end of file
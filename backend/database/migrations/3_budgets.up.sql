@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS budgets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    category TEXT NOT NULL,
+    monthly_limit BIGINT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_budgets_user_category UNIQUE (user_id, category)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_category_date ON transactions(user_id, category, date);
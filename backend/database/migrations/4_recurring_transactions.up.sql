@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    category TEXT NOT NULL DEFAULT '',
+    type TEXT NOT NULL DEFAULT '',
+    amount BIGINT NOT NULL DEFAULT 0,
+    description TEXT NOT NULL DEFAULT '',
+    frequency TEXT NOT NULL,
+    start_date BIGINT NOT NULL,
+    end_date BIGINT NOT NULL DEFAULT 0,
+    next_date BIGINT NOT NULL,
+    runs BIGINT NOT NULL DEFAULT 0,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_recurring_frequency CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_user_id ON recurring_transactions(user_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_due ON recurring_transactions(next_date) WHERE NOT paused;
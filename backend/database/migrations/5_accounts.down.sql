@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+DROP INDEX IF EXISTS idx_transactions_account_id;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS fk_account;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS account_id;
+DROP SEQUENCE IF EXISTS transfers_id_seq;
+DROP TABLE IF EXISTS accounts;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS accounts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    currency TEXT NOT NULL DEFAULT 'RUB',
+    opening_balance BIGINT NOT NULL DEFAULT 0,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_accounts_user_name UNIQUE (user_id, name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_accounts_user_default ON accounts(user_id) WHERE is_default;
+
+INSERT INTO accounts (user_id, name, is_default) SELECT id, 'Основной счёт', TRUE FROM users;
+
+CREATE SEQUENCE IF NOT EXISTS transfers_id_seq;
+
+ALTER TABLE transactions ADD COLUMN account_id BIGINT;
+ALTER TABLE transactions ADD COLUMN transfer_id BIGINT;
+
+UPDATE transactions t SET account_id = a.id FROM accounts a WHERE a.user_id = t.user_id AND a.is_default;
+
+ALTER TABLE transactions ALTER COLUMN account_id SET NOT NULL;
+ALTER TABLE transactions ADD CONSTRAINT fk_account FOREIGN KEY (account_id) REFERENCES accounts(id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account_id ON transactions(account_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_id ON transactions(transfer_id) WHERE transfer_id IS NOT NULL;
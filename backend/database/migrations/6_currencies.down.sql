@@ -0,0 +1,4 @@
+DROP FUNCTION IF EXISTS rub_rate(TEXT, DATE);
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_currency;
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,21 @@
+ALTER TABLE transactions ADD COLUMN currency TEXT NOT NULL DEFAULT 'RUB';
+
+UPDATE transactions t SET currency = a.currency FROM accounts a WHERE a.id = t.account_id AND a.currency <> 'RUB';
+
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_currency CHECK (currency ~ '^[A-Z]{3}$');
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency TEXT NOT NULL,
+    date DATE NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL,
+    CONSTRAINT pk_exchange_rates PRIMARY KEY (currency, date),
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0)
+);
+
+-- rub_rate returns the price of one unit of cur in roubles on the given day,
+-- falling back to the latest earlier rate for weekends and holidays.
+CREATE OR REPLACE FUNCTION rub_rate(cur TEXT, on_date DATE) RETURNS NUMERIC AS $$
+    SELECT CASE WHEN cur = 'RUB' THEN 1::numeric ELSE (
+        SELECT rate FROM exchange_rates WHERE currency = cur AND date <= on_date ORDER BY date DESC LIMIT 1
+    ) END
+$$ LANGUAGE SQL STABLE;
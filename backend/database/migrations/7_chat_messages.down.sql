@@ -0,0 +1 @@
+DROP TABLE IF EXISTS chat_messages;
\ No newline at end of file
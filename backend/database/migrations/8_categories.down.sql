@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS add_default_categories(BIGINT);
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    icon TEXT NOT NULL DEFAULT '',
+    color TEXT NOT NULL DEFAULT '',
+    parent_id BIGINT,
+    type TEXT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_categories_user_name UNIQUE (user_id, name),
+    CONSTRAINT uq_categories_user_id UNIQUE (user_id, id),
+    -- The parent must belong to the same user; subcategories become top-level
+    -- when their parent is deleted.
+    CONSTRAINT fk_parent FOREIGN KEY (user_id, parent_id) REFERENCES categories(user_id, id) ON DELETE SET NULL (parent_id),
+    CONSTRAINT chk_categories_type CHECK (type IN ('Пополнение/Доход', 'Списание/Покупка')),
+    CONSTRAINT chk_categories_parent CHECK (parent_id <> id)
+);
+
+-- add_default_categories gives a user the categories the service used to
+-- offer to everyone.
+CREATE OR REPLACE FUNCTION add_default_categories(uid BIGINT) RETURNS VOID AS $$
+    INSERT INTO categories (user_id, name, type) VALUES
+        (uid, 'Salary', 'Пополнение/Доход'),
+        (uid, 'Food', 'Списание/Покупка'),
+        (uid, 'Shopping', 'Списание/Покупка'),
+        (uid, 'Electronics', 'Списание/Покупка'),
+        (uid, 'Restaurants', 'Списание/Покупка'),
+        (uid, 'Transport', 'Списание/Покупка'),
+        (uid, 'Misc', 'Списание/Покупка')
+    ON CONFLICT (user_id, name) DO NOTHING
+$$ LANGUAGE SQL;
+
+SELECT add_default_categories(id) FROM users;
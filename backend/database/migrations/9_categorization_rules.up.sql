@@ -0,0 +1,25 @@
+-- Rules without a user are global and apply to everyone who has the category.
+CREATE TABLE IF NOT EXISTS categorization_rules (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT,
+    priority INT NOT NULL DEFAULT 0,
+    description_contains TEXT NOT NULL DEFAULT '',
+    description_regex TEXT NOT NULL DEFAULT '',
+    amount_min BIGINT NOT NULL DEFAULT 0,
+    amount_max BIGINT NOT NULL DEFAULT 0,
+    type TEXT NOT NULL DEFAULT '',
+    category TEXT NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_categorization_rules_type CHECK (type IN ('', 'Пополнение/Доход', 'Списание/Покупка')),
+    CONSTRAINT chk_categorization_rules_amount CHECK (amount_max = 0 OR amount_max >= amount_min)
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rules_user_id ON categorization_rules(user_id);
+
+INSERT INTO categorization_rules (description_regex, type, category) VALUES
+    ('зарплат|заработн|аванс|salary', 'Пополнение/Доход', 'Salary'),
+    ('такси|taxi|uber|яндекс\s*go|ситимобил|метро|тройка|азс|лукойл|роснефть|газпромнефть', 'Списание/Покупка', 'Transport'),
+    ('пят[её]рочка|перекр[её]сток|магнит|ашан|лента|вкусвилл|дикси|spar|auchan', 'Списание/Покупка', 'Food'),
+    ('ресторан|кафе|кофе|coffee|макдоналдс|вкусно и точка|kfc|burger|бургер', 'Списание/Покупка', 'Restaurants'),
+    ('м\.?видео|эльдорадо|ситилинк|citilink|dns-shop', 'Списание/Покупка', 'Electronics'),
+    ('wildberries|вайлдберриз|ozon|озон|lamoda|ламода', 'Списание/Покупка', 'Shopping');
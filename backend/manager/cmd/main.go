@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"io"
 	"log"
 	"manager/internal/app"
@@ -29,9 +30,16 @@ func main() {
 	go func() {
 		application.HTTPApp.MustRun()
 	}()
+	go func() {
+		application.ToolsApp.MustRun()
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	go application.Scheduler.Run(ctx)
+	go application.Categorizer.Run(ctx)
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 	<-stop
+	cancel()
 
 	log.Info("Gracefully stopped")
 }
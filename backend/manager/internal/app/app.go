@@ -1,20 +1,32 @@
 package app
 
 import (
+	"crypto/rand"
+	"fmt"
+	categorizerapp "manager/internal/app/categorizer"
 	httpapp "manager/internal/app/http"
+	schedulerapp "manager/internal/app/scheduler"
 	"manager/internal/config"
+	model "manager/internal/models"
+	"manager/internal/ratelimit"
 	botrepo "manager/internal/repository/bot"
 	databaserepo "manager/internal/repository/database"
 	mlrepo "manager/internal/repository/ml"
 	"manager/internal/router"
 	httpservice "manager/internal/services"
+	"manager/internal/session"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/PrototypeSirius/ruglogger/logger"
 	"github.com/sirupsen/logrus"
 )
 
 type App struct {
-	HTTPApp *httpapp.HTTPApp
+	HTTPApp     *httpapp.HTTPApp
+	ToolsApp    *httpapp.HTTPApp
+	Scheduler   *schedulerapp.App
+	Categorizer *categorizerapp.App
 }
 
 func New(log *logrus.Logger, cfg config.Config) *App {
@@ -30,7 +42,24 @@ func New(log *logrus.Logger, cfg config.Config) *App {
 
 	mlClient := mlrepo.New(log, cfg.Client.ML.Host, cfg.Client.ML.Port)
 
-	managerService := httpservice.New(log, botClient, databaseClient, mlClient)
+	secret := []byte(cfg.Session.Secret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			logger.FatalOnError(err, "error generate session secret")
+		}
+		log.Warn("SESSION_SECRET is not set, sessions end when the manager restarts")
+	}
+	sessions := session.New(secret, cfg.Session.AccessTTL, cfg.Session.RefreshTTL)
+
+	limiter := newLimiter(cfg.RateLimit, databaseClient)
+
+	budgetLocation, err := time.LoadLocation(cfg.Budget.Timezone)
+	if err != nil {
+		logger.FatalOnError(err, "error load budget timezone")
+	}
+
+	managerService := httpservice.New(log, botClient, databaseClient, mlClient, sessions, limiter, budgetLocation)
 
 	httpHandler := router.New(log, managerService)
 
@@ -38,5 +67,38 @@ func New(log *logrus.Logger, cfg config.Config) *App {
 
 	httpHandler.RouterRegister(engine)
 
-	return &App{HTTPApp: httpServer}
+	toolsServer, toolsEngine := httpapp.New(log, cfg.Tools.Port)
+
+	if cfg.Tools.Secret == "" {
+		log.Warn("TOOLS_SECRET is not set, the tools port trusts every caller")
+	}
+	httpHandler.ToolsRegister(toolsEngine, cfg.Tools.Secret)
+
+	scheduler := schedulerapp.New(log, managerService, cfg.Scheduler.Interval)
+
+	c := cfg.Categorizer
+	categorizer := categorizerapp.New(log, managerService, c.Workers, c.Interval, c.Timeout, c.MaxAttempts)
+
+	return &App{HTTPApp: httpServer, ToolsApp: toolsServer, Scheduler: scheduler, Categorizer: categorizer}
+}
+
+func newLimiter(cfg config.RateLimitConfig, db *databaserepo.DBClient) *ratelimit.Limiter {
+	limits := map[string]model.RateLimit{
+		model.RateLimitChat:   model.RateLimit(cfg.Chat),
+		model.RateLimitAdvice: model.RateLimit(cfg.Advice),
+		model.RateLimitParse:  model.RateLimit(cfg.Parse),
+	}
+	for key, l := range limits {
+		if l.Burst > 0 && l.PerMinute <= 0 {
+			logger.FatalOnError(fmt.Errorf("rate limit %s has a burst but no per_minute", key), "error init rate limiter")
+		}
+	}
+	switch cfg.Store {
+	case "memory":
+		return ratelimit.New(ratelimit.NewMemory(), limits)
+	case "postgres":
+		return ratelimit.New(db, limits)
+	}
+	logger.FatalOnError(fmt.Errorf("unknown rate limit store %q", cfg.Store), "error init rate limiter")
+	return nil
 }
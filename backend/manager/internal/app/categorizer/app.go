@@ -0,0 +1,70 @@
+package categorizerapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/logger"
+	"github.com/sirupsen/logrus"
+)
+
+type JobRunner interface {
+	RunCategorizationJob(ctx context.Context, now time.Time, timeout time.Duration, maxAttempts int64) (bool, error)
+}
+
+// App is a pool of workers draining the categorization queue. A worker that
+// finds the queue empty polls it again after interval.
+type App struct {
+	log         *logrus.Logger
+	service     JobRunner
+	workers     int
+	interval    time.Duration
+	timeout     time.Duration
+	maxAttempts int64
+}
+
+func New(log *logrus.Logger, service JobRunner, workers int, interval, timeout time.Duration, maxAttempts int64) *App {
+	return &App{
+		log:         log,
+		service:     service,
+		workers:     workers,
+		interval:    interval,
+		timeout:     timeout,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (a *App) Run(ctx context.Context) {
+	a.log.Info("Starting the categorization workers", logrus.Fields{"workers": a.workers, "interval": a.interval.String()})
+	var wg sync.WaitGroup
+	for i := 0; i < a.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.work(ctx)
+		}()
+	}
+	wg.Wait()
+	a.log.Info("Stopping the categorization workers")
+}
+
+func (a *App) work(ctx context.Context) {
+	for {
+		found, err := a.service.RunCategorizationJob(ctx, time.Now(), a.timeout, a.maxAttempts)
+		if err != nil {
+			logger.LogOnError(err, "Failed to run categorization job")
+		}
+		if found && err == nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(a.interval):
+		}
+	}
+}
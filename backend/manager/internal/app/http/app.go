@@ -28,7 +28,7 @@ func New(log *logrus.Logger, port int) (*HTTPApp, *gin.Engine) {
 		AllowOrigins:     []string{"http://127.0.0.1:8080"},
 		AllowMethods:     []string{"GET", "POST"},
 		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length", "Authorization"},
+		ExposeHeaders:    []string{"Content-Length", "Authorization", "Retry-After", "Content-Disposition"},
 		AllowCredentials: true,
 	}))
 	return &HTTPApp{
@@ -0,0 +1,44 @@
+package schedulerapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/logger"
+	"github.com/sirupsen/logrus"
+)
+
+type RecurringMaterializer interface {
+	MaterializeDueRecurring(ctx context.Context, now time.Time) error
+}
+
+type App struct {
+	log      *logrus.Logger
+	service  RecurringMaterializer
+	interval time.Duration
+}
+
+func New(log *logrus.Logger, service RecurringMaterializer, interval time.Duration) *App {
+	return &App{
+		log:      log,
+		service:  service,
+		interval: interval,
+	}
+}
+
+func (a *App) Run(ctx context.Context) {
+	a.log.Info("Starting the recurring transactions scheduler", logrus.Fields{"interval": a.interval.String()})
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		if err := a.service.MaterializeDueRecurring(ctx, time.Now()); err != nil {
+			logger.LogOnError(err, "Failed to materialize recurring transactions")
+		}
+		select {
+		case <-ctx.Done():
+			a.log.Info("Stopping the recurring transactions scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
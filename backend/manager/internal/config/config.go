@@ -3,14 +3,60 @@ package config
 import (
 	"flag"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env        string       `yaml:"env" env-default:"local"` // local, production
-	HttpServer HttpConfig   `yaml:"httpserver"`              // http server config
-	Client     ClientConfig `yaml:"client"`                  // client config
+	Env         string            `yaml:"env" env-default:"local"` // local, production
+	HttpServer  HttpConfig        `yaml:"httpserver"`              // http server config
+	Tools       ToolsConfig       `yaml:"tools"`                   // internal chat tools server config
+	Session     SessionConfig     `yaml:"session"`                 // webapp session tokens config
+	Client      ClientConfig      `yaml:"client"`                  // client config
+	Scheduler   SchedulerConfig   `yaml:"scheduler"`               // scheduler config
+	Categorizer CategorizerConfig `yaml:"categorizer"`             // categorization workers config
+	RateLimit   RateLimitConfig   `yaml:"ratelimit"`               // limits of the LLM-backed endpoints
+	Budget      BudgetConfig      `yaml:"budget"`                  // monthly budgets config
+}
+
+type BudgetConfig struct {
+	Timezone string `yaml:"timezone" env:"BUDGET_TIMEZONE" env-default:"Europe/Moscow"` // IANA zone the budget months start in
+}
+
+type ToolsConfig struct {
+	Port   int    `yaml:"port" env-default:"8083"`   // HTTP port, must not be published outside the network
+	Secret string `yaml:"secret" env:"TOOLS_SECRET"` // shared with the ml service; required outside local, routes open when empty
+}
+
+type SessionConfig struct {
+	Secret     string        `yaml:"secret" env:"SESSION_SECRET"`    // token signing key; required outside local, random per start when empty
+	AccessTTL  time.Duration `yaml:"access_ttl" env-default:"15m"`   // lifetime of an access token
+	RefreshTTL time.Duration `yaml:"refresh_ttl" env-default:"168h"` // lifetime of a refresh token
+}
+
+type RateLimitConfig struct {
+	Store  string      `yaml:"store" env:"RATE_LIMIT_STORE" env-default:"memory"` // memory, or postgres to share the counters between replicas
+	Chat   LimitConfig `yaml:"chat"`                                              // /webapp/chat and /webapp/chat/stream
+	Advice LimitConfig `yaml:"advice"`                                            // /webapp/advice and /webapp/advice/stream
+	Parse  LimitConfig `yaml:"parse"`                                             // /webapp/parse
+}
+
+type LimitConfig struct {
+	PerMinute float64 `yaml:"per_minute"` // requests added to the bucket per minute
+	Burst     int64   `yaml:"burst"`      // bucket size, 0 for no bucket
+	Daily     int64   `yaml:"daily"`      // requests per UTC day, 0 for no quota
+}
+
+type SchedulerConfig struct {
+	Interval time.Duration `yaml:"interval" env-default:"1m"` // recurring transactions check interval
+}
+
+type CategorizerConfig struct {
+	Workers     int           `yaml:"workers" env-default:"2"`      // concurrent categorization jobs
+	Interval    time.Duration `yaml:"interval" env-default:"5s"`    // queue poll interval when idle
+	Timeout     time.Duration `yaml:"timeout" env-default:"5m"`     // time limit of one attempt
+	MaxAttempts int64         `yaml:"max_attempts" env-default:"5"` // attempts before a job is given up
 }
 
 type ClientConfig struct {
@@ -36,6 +82,14 @@ func MustLoad() *Config {
 	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
 		panic("failed to load config: " + err.Error())
 	}
+	// A random key would log every user out on each restart and differ
+	// between replicas.
+	if cfg.Env != "local" && cfg.Session.Secret == "" {
+		panic("SESSION_SECRET is required in the " + cfg.Env + " env")
+	}
+	if cfg.Env != "local" && cfg.Tools.Secret == "" {
+		panic("TOOLS_SECRET is required in the " + cfg.Env + " env")
+	}
 	return &cfg
 }
 
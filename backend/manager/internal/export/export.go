@@ -0,0 +1,222 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	model "manager/internal/models"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+	FormatJSON = "json"
+
+	dateLayout = "2006-01-02 15:04"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+var header = []string{"Date", "Category", "Type", "Amount", "Currency", "Description"}
+
+// Row is the human-readable form of a transaction: a local date instead of
+// epoch milliseconds, income/expense instead of the stored type string and an
+// amount that is negative for expenses.
+type Row struct {
+	Date        string `json:"date"`
+	Kategoria   string `json:"category"`
+	Type        string `json:"type"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description"`
+}
+
+// NewRow builds the row of t. With a base currency the amount converted into
+// it is used; a transaction without a known rate keeps its own currency.
+func NewRow(t model.Transaction, base string) Row {
+	row := Row{
+		Date:        time.UnixMilli(t.Date).Format(dateLayout),
+		Kategoria:   t.Kategoria,
+		Type:        "income",
+		Amount:      t.Amount,
+		Currency:    t.Currency,
+		Description: t.Description,
+	}
+	if base != "" && t.BaseAmount != nil {
+		row.Amount = *t.BaseAmount
+		row.Currency = base
+	}
+	if t.Type == model.TypeExpense {
+		row.Type = "expense"
+		row.Amount = -row.Amount
+	}
+	return row
+}
+
+type Writer interface {
+	Write(rows []Row) error
+	Close() error
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	case FormatJSON:
+		return newJSONWriter(w), nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/json; charset=utf-8"
+}
+
+type csvWriter struct {
+	out    io.Writer
+	w      *csv.Writer
+	header bool
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	return &csvWriter{out: w, w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(rows []Row) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := c.w.Write([]string{r.Date, r.Kategoria, r.Type, strconv.FormatInt(r.Amount, 10), r.Currency, r.Description}); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// writeHeader is deferred until the first rows arrive so that nothing reaches
+// the client before the first page has been fetched.
+func (c *csvWriter) writeHeader() error {
+	if c.header {
+		return nil
+	}
+	c.header = true
+	// The BOM makes Excel open the file as UTF-8 instead of the system code page.
+	if _, err := io.WriteString(c.out, "\xef\xbb\xbf"); err != nil {
+		return err
+	}
+	return c.w.Write(header)
+}
+
+type jsonWriter struct {
+	w     *bufio.Writer
+	enc   *json.Encoder
+	wrote bool
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	bw := bufio.NewWriter(w)
+	return &jsonWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func (j *jsonWriter) Write(rows []Row) error {
+	for _, r := range rows {
+		sep := ","
+		if !j.wrote {
+			sep = "["
+			j.wrote = true
+		}
+		if _, err := j.w.WriteString(sep); err != nil {
+			return err
+		}
+		if err := j.enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return j.w.Flush()
+}
+
+func (j *jsonWriter) Close() error {
+	end := "]\n"
+	if !j.wrote {
+		end = "[]\n"
+	}
+	if _, err := j.w.WriteString(end); err != nil {
+		return err
+	}
+	return j.w.Flush()
+}
+
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	sw, err := f.NewStreamWriter("Sheet1")
+	if err != nil {
+		return nil, err
+	}
+	x := &xlsxWriter{out: w, file: f, sw: sw, row: 1}
+	cells := make([]interface{}, len(header))
+	for i, h := range header {
+		cells[i] = h
+	}
+	if err := x.writeRow(cells); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxWriter) Write(rows []Row) error {
+	for _, r := range rows {
+		if err := x.writeRow([]interface{}{r.Date, r.Kategoria, r.Type, r.Amount, r.Currency, r.Description}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *xlsxWriter) writeRow(cells []interface{}) error {
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	x.row++
+	return x.sw.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.sw.Flush(); err != nil {
+		return fmt.Errorf("flush xlsx sheet: %w", err)
+	}
+	return x.file.Write(x.out)
+}
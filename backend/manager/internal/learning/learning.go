@@ -0,0 +1,126 @@
+package learning
+
+import (
+	model "manager/internal/models"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minSimilarity keeps examples that share little more than common letter
+// combinations out of the prompt.
+const minSimilarity = 0.2
+
+// stopWords are frequent in bank descriptions but say nothing about the
+// merchant.
+var stopWords = map[string]bool{
+	"оплата": true, "покупка": true, "товаров": true, "услуг": true, "перевод": true,
+	"карта": true, "карте": true, "карты": true, "ооо": true, "зао": true, "пао": true,
+	"retail": true, "purchase": true, "payment": true, "pos": true, "rus": true,
+	"moscow": true, "москва": true, "card": true, "the": true,
+}
+
+// Examples picks up to n corrections most similar to t as few-shot examples.
+// Only the latest correction of each description is used, and only if its
+// category is one of allowed.
+func Examples(corrections []model.CategoryCorrection, t model.TransactionMl, allowed []string, n int) []model.CategoryExample {
+	target := trigrams(t.Description)
+	if len(target) == 0 {
+		return nil
+	}
+	type scored struct {
+		example model.CategoryExample
+		score   float64
+	}
+	var candidates []scored
+	seen := make(map[string]bool)
+	for _, c := range corrections {
+		key := normalize(c.Description)
+		if seen[key] || !contains(allowed, c.NewKategoria) {
+			continue
+		}
+		seen[key] = true
+		score := jaccard(target, trigrams(c.Description))
+		if score < minSimilarity {
+			continue
+		}
+		candidates = append(candidates, scored{
+			example: model.CategoryExample{
+				Description: c.Description,
+				Type:        c.Type,
+				Amount:      c.Amount,
+				Kategoria:   c.NewKategoria,
+			},
+			score: score,
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	examples := make([]model.CategoryExample, 0, min(n, len(candidates)))
+	for _, c := range candidates[:min(n, len(candidates))] {
+		examples = append(examples, c.example)
+	}
+	return examples
+}
+
+// MerchantKey guesses the merchant of a bank description: its first word that
+// is not a number or a stop word. It returns "" if there is none.
+func MerchantKey(description string) string {
+	for _, word := range strings.Fields(normalize(description)) {
+		if len([]rune(word)) >= 3 && !stopWords[word] {
+			return word
+		}
+	}
+	return ""
+}
+
+// Corrections counts the corrections of the merchant key to kategoria.
+func Corrections(corrections []model.CategoryCorrection, key, kategoria string) int {
+	count := 0
+	for _, c := range corrections {
+		if c.NewKategoria == kategoria && MerchantKey(c.Description) == key {
+			count++
+		}
+	}
+	return count
+}
+
+// normalize lowercases s and keeps only letter sequences separated by single
+// spaces.
+func normalize(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}), " ")
+}
+
+func trigrams(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(normalize(s)) {
+		runes := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for g := range a {
+		if b[g] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,10 @@
 package model
 
+const (
+	TypeIncome  = "Пополнение/Доход"
+	TypeExpense = "Списание/Покупка"
+)
+
 type Transaction struct {
 	ID          int64  `json:"id"`
 	Date        int64  `json:"date"`
@@ -7,6 +12,13 @@ type Transaction struct {
 	Type        string `json:"type"`
 	Amount      int64  `json:"amount"`
 	Description string `json:"description"`
+	AccountID   int64  `json:"account_id"`
+	TransferID  int64  `json:"transfer_id,omitempty"`
+	Currency    string `json:"currency"`
+	BaseAmount  *int64 `json:"base_amount,omitempty"`
+	// CategoryPending is set while the category is being chosen in the
+	// background.
+	CategoryPending bool `json:"category_pending,omitempty"`
 }
 
 type TransactionMl struct {
@@ -14,8 +26,339 @@ type TransactionMl struct {
 	Type        string `json:"type"`
 	Amount      int64  `json:"amount"`
 	Description string `json:"description"`
+	AccountID   int64  `json:"account_id,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+}
+
+// ParseRequest is a sentence describing transactions. Timezone is the IANA
+// zone relative dates are resolved in. With Insert the transactions are
+// added right away, to AccountID if it is set.
+type ParseRequest struct {
+	Text      string `json:"text" binding:"required"`
+	Timezone  string `json:"tz"`
+	Insert    bool   `json:"insert"`
+	AccountID int64  `json:"account_id"`
+}
+
+// ParsedTransaction has Categorization set only once it has been added.
+type ParsedTransaction struct {
+	Transaction    TransactionMl   `json:"transaction"`
+	Categorization *Categorization `json:"categorization,omitempty"`
+	Budget         *Budget         `json:"budget,omitempty"`
 }
 
 type UserID struct {
 	Uid int64 `json:"uid"`
 }
+
+type TransactionQuery struct {
+	DateFrom     int64    `form:"date_from"`
+	DateTo       int64    `form:"date_to"`
+	Kategorias   []string `form:"kategoria"`
+	Type         string   `form:"type"`
+	AmountMin    *int64   `form:"amount_min"`
+	AmountMax    *int64   `form:"amount_max"`
+	Description  string   `form:"q"`
+	AccountID    int64    `form:"account_id"`
+	SortBy       string   `form:"sort"`
+	Cursor       string   `form:"cursor"`
+	Limit        int64    `form:"limit"`
+	BaseCurrency string   `form:"base"`
+	RateDate     int64    `form:"rate_date"`
+}
+
+type TransactionPage struct {
+	Transactions []Transaction `json:"data"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+	Total        int64         `json:"total"`
+	BaseCurrency string        `json:"base_currency,omitempty"`
+}
+
+type Budget struct {
+	ID        int64  `json:"id"`
+	Kategoria string `json:"kategoria" binding:"required"`
+	Limit     int64  `json:"limit" binding:"required,gt=0"`
+	Spent     int64  `json:"spent"`
+	Remaining int64  `json:"remaining"`
+	Exceeded  bool   `json:"exceeded"`
+	// Added is the part of Spent from the transaction the budget was checked
+	// for.
+	Added int64 `json:"-"`
+}
+
+type Recurring struct {
+	ID          int64  `json:"id"`
+	UserID      int64  `json:"-"`
+	Kategoria   string `json:"kategoria"`
+	Type        string `json:"type" binding:"required"`
+	Amount      int64  `json:"amount" binding:"gt=0"`
+	Description string `json:"description"`
+	Frequency   string `json:"frequency" binding:"required,oneof=daily weekly monthly yearly"`
+	StartDate   int64  `json:"start_date" binding:"required"`
+	EndDate     int64  `json:"end_date"`
+	NextDate    int64  `json:"next_date"`
+	Runs        int64  `json:"runs"`
+	Paused      bool   `json:"paused"`
+}
+
+type ImportRow struct {
+	Line           int             `json:"line"`
+	Status         string          `json:"status"`
+	Transaction    *Transaction    `json:"transaction,omitempty"`
+	Categorization *Categorization `json:"categorization,omitempty"`
+	Reason         string          `json:"reason,omitempty"`
+}
+
+type ImportReport struct {
+	Format     string      `json:"format"`
+	Imported   int         `json:"imported"`
+	Duplicates int         `json:"duplicates"`
+	Rejected   int         `json:"rejected"`
+	Rows       []ImportRow `json:"rows"`
+}
+
+type StatsQuery struct {
+	DateFrom     int64  `form:"date_from"`
+	DateTo       int64  `form:"date_to"`
+	Granularity  string `form:"granularity" binding:"omitempty,oneof=day week month year"`
+	Timezone     string `form:"tz"`
+	TopN         int64  `form:"top" binding:"gte=0"`
+	BaseCurrency string `form:"base"`
+	RateDate     int64  `form:"rate_date"`
+}
+
+type CategoryTotal struct {
+	Kategoria string `json:"kategoria"`
+	Type      string `json:"type"`
+	Amount    int64  `json:"amount"`
+	Count     int64  `json:"count"`
+}
+
+type PeriodTotal struct {
+	PeriodStart int64 `json:"period_start"`
+	Income      int64 `json:"income"`
+	Expense     int64 `json:"expense"`
+	Net         int64 `json:"net"`
+}
+
+type DescriptionTotal struct {
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+	Count       int64  `json:"count"`
+}
+
+type Stats struct {
+	BaseCurrency    string             `json:"base_currency,omitempty"`
+	Income          int64              `json:"income"`
+	Expense         int64              `json:"expense"`
+	Net             int64              `json:"net"`
+	Categories      []CategoryTotal    `json:"categories"`
+	Periods         []PeriodTotal      `json:"periods"`
+	TopDescriptions []DescriptionTotal `json:"top_descriptions"`
+}
+
+type Account struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name" binding:"required"`
+	Currency       string `json:"currency"`
+	OpeningBalance int64  `json:"opening_balance"`
+	Balance        int64  `json:"balance"`
+	IsDefault      bool   `json:"is_default"`
+}
+
+type Transfer struct {
+	FromAccountID int64  `json:"from_account_id" binding:"required"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required,nefield=FromAccountID"`
+	Amount        int64  `json:"amount" binding:"gt=0"`
+	ToAmount      int64  `json:"to_amount" binding:"gte=0"`
+	Date          int64  `json:"date"`
+	Description   string `json:"description"`
+}
+
+type TransferResult struct {
+	TransferID        int64 `json:"transfer_id"`
+	FromTransactionID int64 `json:"from_transaction_id"`
+	ToTransactionID   int64 `json:"to_transaction_id"`
+}
+
+type ChatHistoryQuery struct {
+	Limit  int64 `form:"limit" binding:"gte=0"`
+	Before int64 `form:"before" binding:"gte=0"`
+}
+
+type ChatMessage struct {
+	ID        int64  `json:"id"`
+	Role      string `json:"role"`
+	Content   string `json:"content"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type Category struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name" binding:"required"`
+	Icon     string `json:"icon"`
+	Color    string `json:"color"`
+	ParentID int64  `json:"parent_id"`
+	Type     string `json:"type" binding:"required"`
+}
+
+// Rule assigns Kategoria to transactions matching all of its non-empty
+// conditions. Global rules are shared by all users and read-only.
+type Rule struct {
+	ID                  int64  `json:"id"`
+	Priority            int64  `json:"priority"`
+	DescriptionContains string `json:"description_contains"`
+	DescriptionRegex    string `json:"description_regex"`
+	AmountMin           int64  `json:"amount_min" binding:"gte=0"`
+	AmountMax           int64  `json:"amount_max" binding:"gte=0"`
+	Type                string `json:"type"`
+	Kategoria           string `json:"kategoria" binding:"required"`
+	Global              bool   `json:"global"`
+}
+
+const (
+	CategorizedByRule  = "rule"
+	CategorizedByModel = "model"
+	// CategorizationPending means the transaction was queued for the model.
+	CategorizationPending = "pending"
+)
+
+// Categorization tells which rule or whether the model produced a category.
+// Confidence and Reason come from the model.
+type Categorization struct {
+	Kategoria  string  `json:"kategoria"`
+	Source     string  `json:"source,omitempty"`
+	RuleID     int64   `json:"rule_id,omitempty"`
+	Confidence float64 `json:"confidence,omitempty"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+// CategoryPrediction is the answer of the model for one transaction.
+// Confidence is 0 when the model gave no usable answer. Batch predictions
+// carry no reason.
+type CategoryPrediction struct {
+	Kategoria  string  `json:"kategoria"`
+	Confidence float64 `json:"confidence"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+type CategoryCorrection struct {
+	ID            int64  `json:"id"`
+	TransactionID int64  `json:"transaction_id"`
+	Description   string `json:"description"`
+	Type          string `json:"type"`
+	Amount        int64  `json:"amount"`
+	OldKategoria  string `json:"old_kategoria"`
+	NewKategoria  string `json:"new_kategoria"`
+	CreatedAt     int64  `json:"created_at"`
+}
+
+// CategoryExample is a labelled transaction shown to the model as a few-shot
+// example.
+type CategoryExample struct {
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Amount      int64  `json:"amount"`
+	Kategoria   string `json:"kategoria"`
+}
+
+// CategorizationJob is a queued transaction waiting for its category.
+// Attempts counts this claim and identifies it when the job is finished.
+type CategorizationJob struct {
+	ID            int64
+	UserID        int64
+	TransactionID int64
+	Attempts      int64
+	Transaction   Transaction
+}
+
+const (
+	RecategorizationRunning  = "running"
+	RecategorizationPreview  = "preview"
+	RecategorizationApplying = "applying"
+	RecategorizationDone     = "done"
+	RecategorizationFailed   = "failed"
+)
+
+// RecategorizationRequest selects the transactions to label again. An empty
+// filter selects the whole history. A dry run only lists the changes and
+// stops in the preview status until it is applied.
+type RecategorizationRequest struct {
+	Kategorias []string `json:"kategorias"`
+	DateFrom   int64    `json:"date_from"`
+	DateTo     int64    `json:"date_to"`
+	DryRun     bool     `json:"dry_run"`
+}
+
+// Recategorization is the progress of a re-categorisation of the history.
+type Recategorization struct {
+	ID         int64                   `json:"id"`
+	Status     string                  `json:"status"`
+	Request    RecategorizationRequest `json:"request"`
+	Total      int                     `json:"total"`
+	Processed  int                     `json:"processed"`
+	Changed    int                     `json:"changed"`
+	Applied    int                     `json:"applied"`
+	Error      string                  `json:"error,omitempty"`
+	StartedAt  int64                   `json:"started_at"`
+	FinishedAt int64                   `json:"finished_at,omitempty"`
+	Changes    []CategoryChange        `json:"changes"`
+}
+
+// CategoryChange is a transaction whose category the categoriser would
+// change. Applied is false until it is written, and stays false when the
+// user changed the category in the meantime.
+type CategoryChange struct {
+	TransactionID int64          `json:"transaction_id"`
+	Date          int64          `json:"date"`
+	Type          string         `json:"type"`
+	Amount        int64          `json:"amount"`
+	Description   string         `json:"description"`
+	OldKategoria  string         `json:"old_kategoria"`
+	New           Categorization `json:"new"`
+	Applied       bool           `json:"applied"`
+}
+
+// Tool is a function the chat model may call. Parameters is the JSON schema
+// of its arguments.
+type Tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Parameters  map[string]any `json:"parameters"`
+}
+
+// Session authorises /webapp requests without initData. The expiry times are
+// epoch milliseconds.
+type Session struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresAt        int64  `json:"expires_at"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresAt int64  `json:"refresh_expires_at"`
+}
+
+// Keys of the rate limited endpoints.
+const (
+	RateLimitChat   = "chat"
+	RateLimitAdvice = "advice"
+	RateLimitParse  = "parse"
+)
+
+// RateLimit is a token bucket refilled at PerMinute tokens up to Burst, plus
+// a quota of Daily requests per UTC day. A zero Burst or Daily turns that
+// part off.
+type RateLimit struct {
+	PerMinute float64
+	Burst     int64
+	Daily     int64
+}
+
+// RateLimitState is the counter of one user and endpoint: the token bucket as
+// of UpdatedAt and the requests Used on Day. Version is the version the
+// counter was read at, 0 for a counter never stored.
+type RateLimitState struct {
+	Tokens    float64
+	UpdatedAt int64
+	Day       int64
+	Used      int64
+	Version   int64
+}
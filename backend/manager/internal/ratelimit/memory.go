@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"context"
+	model "manager/internal/models"
+	"sync"
+)
+
+type memoryKey struct {
+	uid int64
+	key string
+}
+
+// Memory keeps the counters in the process, so every replica limits on its
+// own and a restart resets them. It holds one entry per user and endpoint.
+type Memory struct {
+	mu       sync.Mutex
+	counters map[memoryKey]model.RateLimitState
+}
+
+func NewMemory() *Memory {
+	return &Memory{counters: make(map[memoryKey]model.RateLimitState)}
+}
+
+func (m *Memory) RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counters[memoryKey{uid, key}], nil
+}
+
+func (m *Memory) SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := memoryKey{uid, key}
+	if m.counters[k].Version != s.Version {
+		return false, nil
+	}
+	s.Version++
+	m.counters[k] = s
+	return true, nil
+}
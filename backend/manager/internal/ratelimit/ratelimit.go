@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	model "manager/internal/models"
+	"math"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// maxSwaps is how often a request retries when other requests of the same
+// user keep writing the counter first.
+const maxSwaps = 5
+
+// Store keeps the counters. The database client is a Store shared by every
+// replica of the manager.
+type Store interface {
+	// RequestRateLimit returns the counter, with Version 0 when there is none.
+	RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, error)
+	// SwapRateLimit stores s unless the counter changed since s.Version.
+	SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, error)
+}
+
+// Limiter applies the configured limit of an endpoint to each user.
+type Limiter struct {
+	store  Store
+	limits map[string]model.RateLimit
+}
+
+func New(store Store, limits map[string]model.RateLimit) *Limiter {
+	return &Limiter{store: store, limits: limits}
+}
+
+// Take counts a request of key for the user. It returns how long the user has
+// to wait when the request is over the limit and zero otherwise. Quotas reset
+// at midnight UTC.
+func (l *Limiter) Take(ctx context.Context, uid int64, key string) (time.Duration, error) {
+	limit, ok := l.limits[key]
+	if !ok || (limit.Burst <= 0 && limit.Daily <= 0) {
+		return 0, nil
+	}
+	now := time.Now().UTC()
+	day := now.Truncate(24 * time.Hour)
+	for range maxSwaps {
+		s, err := l.store.RequestRateLimit(ctx, uid, key)
+		if err != nil {
+			return 0, err
+		}
+		next, retryAfter := Take(s, limit, now.UnixMilli(), day.UnixMilli())
+		if retryAfter > 0 {
+			// A refused request is not counted, so nothing is written.
+			return time.Duration(retryAfter) * time.Millisecond, nil
+		}
+		swapped, err := l.store.SwapRateLimit(ctx, uid, key, next)
+		if err != nil {
+			return 0, err
+		}
+		if swapped {
+			return 0, nil
+		}
+	}
+	return 0, apperror.SystemError(errors.New("rate limit counter keeps changing"), 4045, "Failed to take rate limit")
+}
+
+// Take refills the bucket of s up to now and decides on one request. It
+// returns the counter with the request counted and zero when the request is
+// allowed, and otherwise the milliseconds until it would be. day is the start
+// of the current day; a counter never stored starts with a full bucket.
+func Take(s model.RateLimitState, l model.RateLimit, now, day int64) (model.RateLimitState, int64) {
+	if s.Version == 0 {
+		s = model.RateLimitState{Tokens: float64(l.Burst), UpdatedAt: now, Day: day}
+	}
+	s.Tokens = math.Min(float64(l.Burst), s.Tokens+float64(max(now-s.UpdatedAt, 0))*l.PerMinute/float64(time.Minute.Milliseconds()))
+	s.UpdatedAt = now
+	if s.Day != day {
+		s.Day = day
+		s.Used = 0
+	}
+	switch {
+	case l.Daily > 0 && s.Used >= l.Daily:
+		return s, day + (24 * time.Hour).Milliseconds() - now
+	case l.Burst > 0 && s.Tokens < 1:
+		return s, int64(math.Ceil((1 - s.Tokens) * float64(time.Minute.Milliseconds()) / l.PerMinute))
+	}
+	if l.Burst > 0 {
+		s.Tokens--
+	}
+	s.Used++
+	return s, 0
+}
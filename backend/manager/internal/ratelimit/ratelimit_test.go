@@ -0,0 +1,137 @@
+package ratelimit
+
+import (
+	"context"
+	model "manager/internal/models"
+	"testing"
+	"time"
+)
+
+func TestTake(t *testing.T) {
+	const (
+		day = int64(1_700_006_400_000) // midnight UTC
+		now = day + int64(12*time.Hour/time.Millisecond)
+	)
+	// One token every 10 seconds, two at most, three requests a day.
+	limit := model.RateLimit{PerMinute: 6, Burst: 2, Daily: 3}
+	tests := []struct {
+		name       string
+		limit      model.RateLimit
+		state      model.RateLimitState
+		want       model.RateLimitState
+		retryAfter int64
+	}{
+		{
+			name:  "new counter starts full",
+			limit: limit,
+			want:  model.RateLimitState{Tokens: 1, UpdatedAt: now, Day: day, Used: 1},
+		},
+		{
+			name:  "burst left",
+			limit: limit,
+			state: model.RateLimitState{Tokens: 2, UpdatedAt: now, Day: day, Used: 1, Version: 4},
+			want:  model.RateLimitState{Tokens: 1, UpdatedAt: now, Day: day, Used: 2, Version: 4},
+		},
+		{
+			name:       "empty bucket waits for a token",
+			limit:      limit,
+			state:      model.RateLimitState{Tokens: 0, UpdatedAt: now, Day: day, Used: 2, Version: 4},
+			want:       model.RateLimitState{Tokens: 0, UpdatedAt: now, Day: day, Used: 2, Version: 4},
+			retryAfter: 10_000,
+		},
+		{
+			name:       "partial refill waits for the rest",
+			limit:      limit,
+			state:      model.RateLimitState{Tokens: 0, UpdatedAt: now - 4_000, Day: day, Used: 2, Version: 4},
+			want:       model.RateLimitState{Tokens: 0.4, UpdatedAt: now, Day: day, Used: 2, Version: 4},
+			retryAfter: 6_000,
+		},
+		{
+			name:  "refill",
+			limit: limit,
+			state: model.RateLimitState{Tokens: 0.5, UpdatedAt: now - 5_000, Day: day, Used: 2, Version: 4},
+			want:  model.RateLimitState{Tokens: 0, UpdatedAt: now, Day: day, Used: 3, Version: 4},
+		},
+		{
+			name:  "refill stops at burst",
+			limit: model.RateLimit{PerMinute: 6, Burst: 2},
+			state: model.RateLimitState{Tokens: 0, UpdatedAt: now - time.Hour.Milliseconds(), Day: day, Used: 2, Version: 4},
+			want:  model.RateLimitState{Tokens: 1, UpdatedAt: now, Day: day, Used: 3, Version: 4},
+		},
+		{
+			name:  "clock going back does not refill",
+			limit: limit,
+			state: model.RateLimitState{Tokens: 1, UpdatedAt: now + 60_000, Day: day, Used: 0, Version: 4},
+			want:  model.RateLimitState{Tokens: 0, UpdatedAt: now, Day: day, Used: 1, Version: 4},
+		},
+		{
+			name:       "daily quota waits for midnight",
+			limit:      limit,
+			state:      model.RateLimitState{Tokens: 2, UpdatedAt: now, Day: day, Used: 3, Version: 4},
+			want:       model.RateLimitState{Tokens: 2, UpdatedAt: now, Day: day, Used: 3, Version: 4},
+			retryAfter: 12 * time.Hour.Milliseconds(),
+		},
+		{
+			name:  "daily quota resets on a new day",
+			limit: limit,
+			state: model.RateLimitState{Tokens: 2, UpdatedAt: now, Day: day - 24*time.Hour.Milliseconds(), Used: 3, Version: 4},
+			want:  model.RateLimitState{Tokens: 1, UpdatedAt: now, Day: day, Used: 1, Version: 4},
+		},
+		{
+			name:  "quota without bucket",
+			limit: model.RateLimit{Daily: 3},
+			state: model.RateLimitState{UpdatedAt: now, Day: day, Used: 2, Version: 4},
+			want:  model.RateLimitState{UpdatedAt: now, Day: day, Used: 3, Version: 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, retryAfter := Take(tt.state, tt.limit, now, day)
+			if retryAfter != tt.retryAfter {
+				t.Errorf("retry after = %d, want %d", retryAfter, tt.retryAfter)
+			}
+			if d := got.Tokens - tt.want.Tokens; d > 1e-9 || d < -1e-9 {
+				t.Errorf("tokens = %v, want %v", got.Tokens, tt.want.Tokens)
+			}
+			got.Tokens = tt.want.Tokens
+			if got != tt.want {
+				t.Errorf("state = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterMemory(t *testing.T) {
+	ctx := context.Background()
+	l := New(NewMemory(), map[string]model.RateLimit{"chat": {PerMinute: 1, Burst: 2}})
+	for i := range 2 {
+		if wait, err := l.Take(ctx, 1, "chat"); err != nil || wait != 0 {
+			t.Fatalf("request %d: wait %v, err %v; want it allowed", i+1, wait, err)
+		}
+	}
+	wait, err := l.Take(ctx, 1, "chat")
+	if err != nil || wait <= 0 || wait > time.Minute {
+		t.Fatalf("third request: wait %v, err %v; want refused for up to a minute", wait, err)
+	}
+	if wait, err := l.Take(ctx, 2, "chat"); err != nil || wait != 0 {
+		t.Fatalf("other user: wait %v, err %v; want allowed", wait, err)
+	}
+	if wait, err := l.Take(ctx, 1, "advice"); err != nil || wait != 0 {
+		t.Fatalf("unlimited key: wait %v, err %v; want allowed", wait, err)
+	}
+}
+
+func TestMemorySwapStaleVersion(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+	if ok, _ := m.SwapRateLimit(ctx, 1, "chat", model.RateLimitState{Used: 1}); !ok {
+		t.Fatal("first swap was refused")
+	}
+	if ok, _ := m.SwapRateLimit(ctx, 1, "chat", model.RateLimitState{Used: 2}); ok {
+		t.Fatal("swap from a stale version was stored")
+	}
+	s, _ := m.RequestRateLimit(ctx, 1, "chat")
+	if s.Used != 1 || s.Version != 1 {
+		t.Fatalf("counter = %+v, want the first swap at version 1", s)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"manager/internal/repository/grpcerror"
 
 	cyberbott "github.com/PrototypeSirius/protos_service/gen/cybergarden/bot"
 	"github.com/PrototypeSirius/ruglogger/apperror"
@@ -21,7 +22,7 @@ func New(log *logrus.Logger, host string, port int) (*BotClient, error) {
 	botaddr := fmt.Sprintf("%s:%d", host, port)
 	bcc, err := grpc.NewClient(botaddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return nil, apperror.SystemError(err, 1021, "error load bot service")
+		return nil, apperror.SystemError(err, 6021, "error load bot service")
 	}
 	return &BotClient{bot: cyberbott.NewBotClient(bcc), log: log}, nil
 }
@@ -31,10 +32,21 @@ func (c *BotClient) Auth(ctx context.Context, initData string) (int64, error) {
 		AuthData: initData,
 	})
 	if err != nil {
-		return 0, apperror.SystemError(err, 1022, "grpc auth request failed")
+		return 0, grpcerror.Wrap(err, 6022, "grpc auth request failed")
 	}
 	if !resp.GetAuthorized() {
-		return 0, apperror.BadRequestError(errors.New(resp.GetError()), 1023, "user not authorized by bot service")
+		return 0, apperror.BadRequestError(errors.New(resp.GetError()), 6023, "user not authorized by bot service")
 	}
 	return resp.GetUserID(), nil
 }
+
+func (c *BotClient) SendMessage(ctx context.Context, uid int64, text string) error {
+	_, err := c.bot.SendMessage(ctx, &cyberbott.SendMessageRequest{
+		UserID:  uid,
+		Message: text,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6024, "grpc send message failed")
+	}
+	return nil
+}
@@ -2,8 +2,12 @@ package databaserepo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	model "manager/internal/models"
+	"manager/internal/repository/grpcerror"
+	"strings"
+	"time"
 
 	cyberdatabase "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
 	"github.com/PrototypeSirius/ruglogger/apperror"
@@ -21,7 +25,7 @@ func New(log *logrus.Logger, host string, port int) (*DBClient, error) {
 	dbaddr := fmt.Sprintf("%s:%d", host, port)
 	dcc, err := grpc.NewClient(dbaddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return nil, apperror.SystemError(err, 1021, "error load database service")
+		return nil, apperror.SystemError(err, 6021, "error load database service")
 	}
 	return &DBClient{db: cyberdatabase.NewDatabaseClient(dcc), log: logrus.New()}, nil
 }
@@ -29,7 +33,7 @@ func New(log *logrus.Logger, host string, port int) (*DBClient, error) {
 func (c *DBClient) AddUser(ctx context.Context, uid int64) error {
 	resp, err := c.db.AddUser(ctx, &cyberdatabase.AddUserRequest{UserID: uid})
 	if err != nil {
-		return apperror.SystemError(err, 1031, "grpc add user failed")
+		return grpcerror.Wrap(err, 6031, "grpc add user failed")
 	}
 	if resp.ErrorMes != "" && resp.ErrorMes != "success" {
 		c.log.Warnf("AddUser response: %s", resp.ErrorMes)
@@ -37,15 +41,34 @@ func (c *DBClient) AddUser(ctx context.Context, uid int64) error {
 	return nil
 }
 
-func (c *DBClient) AddTransaction(ctx context.Context, uid int64, t model.Transaction) error {
-	_, err := c.db.AddTransaction(ctx, &cyberdatabase.AddTransactionRequest{
+// AddTransaction stores t and returns its id. With categorize it is stored
+// with a pending category and queued for categorization.
+func (c *DBClient) AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, error) {
+	resp, err := c.db.AddTransaction(ctx, &cyberdatabase.AddTransactionRequest{
 		UserID:      uid,
 		Transaction: mapModelToProto(t),
+		Categorize:  categorize,
 	})
 	if err != nil {
-		return apperror.SystemError(err, 1032, "grpc add transaction failed")
+		return 0, grpcerror.Wrap(err, 6032, "grpc add transaction failed")
 	}
-	return nil
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, error) {
+	protoTransactions := make([]*cyberdatabase.Transaction, 0, len(ts))
+	for _, t := range ts {
+		protoTransactions = append(protoTransactions, mapModelToProto(t))
+	}
+	resp, err := c.db.AddTransactions(ctx, &cyberdatabase.AddTransactionsRequest{
+		UserID:         uid,
+		Transactions:   protoTransactions,
+		SkipDuplicates: skipDuplicates,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6045, "grpc add transactions failed")
+	}
+	return resp.GetIDs(), nil
 }
 
 func mapModelToProto(t model.Transaction) *cyberdatabase.Transaction {
@@ -56,6 +79,8 @@ func mapModelToProto(t model.Transaction) *cyberdatabase.Transaction {
 		Type:        t.Type,
 		Amount:      t.Amount,
 		Description: t.Description,
+		AccountID:   t.AccountID,
+		Currency:    t.Currency,
 	}
 }
 
@@ -65,40 +90,717 @@ func (c *DBClient) DeleteTransaction(ctx context.Context, uid, tid int64) error
 		ID:     tid,
 	})
 	if err != nil {
-		return apperror.SystemError(err, 1033, "grpc delete transaction failed")
+		return grpcerror.Wrap(err, 6033, "grpc delete transaction failed")
 	}
 	return nil
 }
 
-func (c *DBClient) EditTransaction(ctx context.Context, uid int64, t model.Transaction) error {
-	_, err := c.db.EditTransaction(ctx, &cyberdatabase.EditTransactionRequest{
+// EditTransaction reports whether the edit changed the category of t.
+func (c *DBClient) EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, error) {
+	resp, err := c.db.EditTransaction(ctx, &cyberdatabase.EditTransactionRequest{
 		UserID:      uid,
 		Transaction: mapModelToProto(t),
 	})
 	if err != nil {
-		return apperror.SystemError(err, 1034, "grpc edit transaction failed")
+		return false, grpcerror.Wrap(err, 6034, "grpc edit transaction failed")
 	}
-	return nil
+	return resp.GetCategoryCorrected(), nil
 }
 
-func (c *DBClient) RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, error) {
+// RecategorizeTransaction writes the category chosen by the categoriser if the
+// transaction still has the previous one and reports whether it did.
+func (c *DBClient) RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, error) {
+	resp, err := c.db.EditTransaction(ctx, &cyberdatabase.EditTransactionRequest{
+		UserID:            uid,
+		Transaction:       &cyberdatabase.Transaction{ID: tid, Kategoria: kategoria},
+		Recategorize:      true,
+		PreviousKategoria: previous,
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6065, "grpc recategorize transaction failed")
+	}
+	return resp.GetApplied(), nil
+}
+
+func (c *DBClient) RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, error) {
 	resp, err := c.db.RequestUserTransactions(ctx, &cyberdatabase.RequestUserTransactionsRequest{
 		UserID: uid,
+		Filter: &cyberdatabase.TransactionFilter{
+			DateFrom:    q.DateFrom,
+			DateTo:      q.DateTo,
+			Kategorias:  q.Kategorias,
+			Type:        q.Type,
+			AmountMin:   q.AmountMin,
+			AmountMax:   q.AmountMax,
+			Description: q.Description,
+			AccountID:   q.AccountID,
+		},
+		SortBy:       q.SortBy,
+		Cursor:       q.Cursor,
+		Limit:        q.Limit,
+		BaseCurrency: strings.ToUpper(q.BaseCurrency),
+		RateDate:     q.RateDate,
 	})
 	if err != nil {
-		return nil, apperror.SystemError(err, 1035, "grpc request transactions failed")
+		return model.TransactionPage{}, grpcerror.Wrap(err, 6035, "grpc request transactions failed")
 	}
 	transactions := make([]model.Transaction, 0)
 	for _, t := range resp.GetTransactions() {
 		transactions = append(transactions, model.Transaction{
-			ID:          t.GetID(),
-			Date:        t.GetDate(),
-			Kategoria:   t.GetKategoria(),
-			Type:        t.GetType(),
-			Amount:      t.GetAmount(),
+			ID:              t.GetID(),
+			Date:            t.GetDate(),
+			Kategoria:       t.GetKategoria(),
+			Type:            t.GetType(),
+			Amount:          t.GetAmount(),
+			Description:     t.GetDescription(),
+			AccountID:       t.GetAccountID(),
+			TransferID:      t.GetTransferID(),
+			Currency:        t.GetCurrency(),
+			BaseAmount:      t.BaseAmount,
+			CategoryPending: t.CategoryPending,
+		})
+	}
+
+	return model.TransactionPage{
+		Transactions: transactions,
+		NextCursor:   resp.GetNextCursor(),
+		Total:        resp.GetTotal(),
+		BaseCurrency: strings.ToUpper(q.BaseCurrency),
+	}, nil
+}
+
+func (c *DBClient) SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, error) {
+	resp, err := c.db.SetBudget(ctx, &cyberdatabase.SetBudgetRequest{
+		UserID: uid,
+		Budget: &cyberdatabase.Budget{
+			ID:        b.ID,
+			Kategoria: b.Kategoria,
+			Limit:     b.Limit,
+		},
+	})
+	if err != nil {
+		return 0, grpcerror.Wrap(err, 6036, "grpc set budget failed")
+	}
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) DeleteBudget(ctx context.Context, uid, bid int64) error {
+	_, err := c.db.DeleteBudget(ctx, &cyberdatabase.DeleteBudgetRequest{
+		UserID: uid,
+		ID:     bid,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6037, "grpc delete budget failed")
+	}
+	return nil
+}
+
+func (c *DBClient) RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, error) {
+	resp, err := c.db.RequestUserBudgets(ctx, &cyberdatabase.RequestUserBudgetsRequest{
+		UserID:        uid,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+		Kategorias:    kategorias,
+		TransactionID: tid,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6038, "grpc request budgets failed")
+	}
+	budgets := make([]model.Budget, 0, len(resp.GetBudgets()))
+	for _, b := range resp.GetBudgets() {
+		budgets = append(budgets, model.Budget{
+			ID:        b.GetID(),
+			Kategoria: b.GetKategoria(),
+			Limit:     b.GetLimit(),
+			Spent:     b.GetSpent(),
+			Remaining: b.GetLimit() - b.GetSpent(),
+			Exceeded:  b.GetSpent() > b.GetLimit(),
+			Added:     b.GetAdded(),
+		})
+	}
+	return budgets, nil
+}
+
+func (c *DBClient) AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, error) {
+	resp, err := c.db.AddRecurring(ctx, &cyberdatabase.AddRecurringRequest{
+		UserID:    uid,
+		Recurring: mapRecurringToProto(r),
+	})
+	if err != nil {
+		return 0, grpcerror.Wrap(err, 6039, "grpc add recurring transaction failed")
+	}
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, error) {
+	resp, err := c.db.RequestUserRecurring(ctx, &cyberdatabase.RequestUserRecurringRequest{UserID: uid})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6040, "grpc request recurring transactions failed")
+	}
+	return mapRecurringFromProto(resp.GetRecurring()), nil
+}
+
+func (c *DBClient) SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) error {
+	_, err := c.db.SetRecurringPaused(ctx, &cyberdatabase.SetRecurringPausedRequest{
+		UserID: uid,
+		ID:     rid,
+		Paused: paused,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6041, "grpc pause recurring transaction failed")
+	}
+	return nil
+}
+
+func (c *DBClient) DeleteRecurring(ctx context.Context, uid, rid int64) error {
+	_, err := c.db.DeleteRecurring(ctx, &cyberdatabase.DeleteRecurringRequest{
+		UserID: uid,
+		ID:     rid,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6042, "grpc delete recurring transaction failed")
+	}
+	return nil
+}
+
+func (c *DBClient) RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, error) {
+	resp, err := c.db.RequestDueRecurring(ctx, &cyberdatabase.RequestDueRecurringRequest{
+		Before: before,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6043, "grpc request due recurring transactions failed")
+	}
+	return mapRecurringFromProto(resp.GetRecurring()), nil
+}
+
+func (c *DBClient) AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, error) {
+	resp, err := c.db.AdvanceRecurring(ctx, &cyberdatabase.AdvanceRecurringRequest{
+		ID:       rid,
+		Runs:     runs,
+		NextRuns: nextRuns,
+		NextDate: nextDate,
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6044, "grpc advance recurring transaction failed")
+	}
+	return resp.GetClaimed(), nil
+}
+
+// MaterializeRecurring claims the occurrence r.Runs of the template and
+// inserts t in one database transaction. It returns false when the occurrence
+// was already claimed.
+func (c *DBClient) MaterializeRecurring(ctx context.Context, r model.Recurring, nextDate int64, t model.Transaction) (bool, error) {
+	resp, err := c.db.MaterializeRecurring(ctx, &cyberdatabase.MaterializeRecurringRequest{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		Runs:        r.Runs,
+		NextDate:    nextDate,
+		Transaction: mapModelToProto(t),
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6067, "grpc materialize recurring transaction failed")
+	}
+	return resp.GetClaimed(), nil
+}
+
+func mapRecurringToProto(r model.Recurring) *cyberdatabase.Recurring {
+	return &cyberdatabase.Recurring{
+		ID:          r.ID,
+		Kategoria:   r.Kategoria,
+		Type:        r.Type,
+		Amount:      r.Amount,
+		Description: r.Description,
+		Frequency:   r.Frequency,
+		StartDate:   r.StartDate,
+		EndDate:     r.EndDate,
+	}
+}
+
+func mapRecurringFromProto(recurring []*cyberdatabase.Recurring) []model.Recurring {
+	res := make([]model.Recurring, 0, len(recurring))
+	for _, r := range recurring {
+		res = append(res, model.Recurring{
+			ID:          r.GetID(),
+			UserID:      r.GetUserID(),
+			Kategoria:   r.GetKategoria(),
+			Type:        r.GetType(),
+			Amount:      r.GetAmount(),
+			Description: r.GetDescription(),
+			Frequency:   r.GetFrequency(),
+			StartDate:   r.GetStartDate(),
+			EndDate:     r.GetEndDate(),
+			NextDate:    r.GetNextDate(),
+			Runs:        r.GetRuns(),
+			Paused:      r.GetPaused(),
+		})
+	}
+	return res
+}
+
+func (c *DBClient) RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, error) {
+	resp, err := c.db.RequestUserStats(ctx, &cyberdatabase.RequestUserStatsRequest{
+		UserID:       uid,
+		DateFrom:     q.DateFrom,
+		DateTo:       q.DateTo,
+		Granularity:  q.Granularity,
+		Timezone:     q.Timezone,
+		TopN:         q.TopN,
+		BaseCurrency: strings.ToUpper(q.BaseCurrency),
+		RateDate:     q.RateDate,
+	})
+	if err != nil {
+		return model.Stats{}, grpcerror.Wrap(err, 6046, "grpc request stats failed")
+	}
+	stats := model.Stats{
+		BaseCurrency:    strings.ToUpper(q.BaseCurrency),
+		Income:          resp.GetIncome(),
+		Expense:         resp.GetExpense(),
+		Net:             resp.GetNet(),
+		Categories:      make([]model.CategoryTotal, 0, len(resp.GetCategories())),
+		Periods:         make([]model.PeriodTotal, 0, len(resp.GetPeriods())),
+		TopDescriptions: make([]model.DescriptionTotal, 0, len(resp.GetTopDescriptions())),
+	}
+	for _, ct := range resp.GetCategories() {
+		stats.Categories = append(stats.Categories, model.CategoryTotal{
+			Kategoria: ct.GetKategoria(),
+			Type:      ct.GetType(),
+			Amount:    ct.GetAmount(),
+			Count:     ct.GetCount(),
+		})
+	}
+	for _, p := range resp.GetPeriods() {
+		stats.Periods = append(stats.Periods, model.PeriodTotal{
+			PeriodStart: p.GetPeriodStart(),
+			Income:      p.GetIncome(),
+			Expense:     p.GetExpense(),
+			Net:         p.GetNet(),
+		})
+	}
+	for _, t := range resp.GetTopDescriptions() {
+		stats.TopDescriptions = append(stats.TopDescriptions, model.DescriptionTotal{
 			Description: t.GetDescription(),
+			Amount:      t.GetAmount(),
+			Count:       t.GetCount(),
 		})
 	}
+	return stats, nil
+}
+
+func (c *DBClient) AddAccount(ctx context.Context, uid int64, a model.Account) (int64, error) {
+	resp, err := c.db.AddAccount(ctx, &cyberdatabase.AddAccountRequest{
+		UserID:  uid,
+		Account: mapAccountToProto(a),
+	})
+	if err != nil {
+		return 0, grpcerror.Wrap(err, 6047, "grpc add account failed")
+	}
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) EditAccount(ctx context.Context, uid int64, a model.Account) error {
+	_, err := c.db.EditAccount(ctx, &cyberdatabase.EditAccountRequest{
+		UserID:  uid,
+		Account: mapAccountToProto(a),
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6048, "grpc edit account failed")
+	}
+	return nil
+}
 
-	return transactions, nil
+func (c *DBClient) DeleteAccount(ctx context.Context, uid, aid int64) error {
+	_, err := c.db.DeleteAccount(ctx, &cyberdatabase.DeleteAccountRequest{
+		UserID: uid,
+		ID:     aid,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6049, "grpc delete account failed")
+	}
+	return nil
+}
+
+func (c *DBClient) RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, error) {
+	resp, err := c.db.RequestUserAccounts(ctx, &cyberdatabase.RequestUserAccountsRequest{UserID: uid})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6050, "grpc request accounts failed")
+	}
+	accounts := make([]model.Account, 0, len(resp.GetAccounts()))
+	for _, a := range resp.GetAccounts() {
+		accounts = append(accounts, model.Account{
+			ID:             a.GetID(),
+			Name:           a.GetName(),
+			Currency:       a.GetCurrency(),
+			OpeningBalance: a.GetOpeningBalance(),
+			Balance:        a.GetBalance(),
+			IsDefault:      a.GetIsDefault(),
+		})
+	}
+	return accounts, nil
+}
+
+func (c *DBClient) Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, error) {
+	resp, err := c.db.Transfer(ctx, &cyberdatabase.TransferRequest{
+		UserID: uid,
+		Transfer: &cyberdatabase.Transfer{
+			FromAccountID: t.FromAccountID,
+			ToAccountID:   t.ToAccountID,
+			Amount:        t.Amount,
+			ToAmount:      t.ToAmount,
+			Date:          t.Date,
+			Description:   t.Description,
+		},
+	})
+	if err != nil {
+		return model.TransferResult{}, grpcerror.Wrap(err, 6051, "grpc transfer failed")
+	}
+	return model.TransferResult{
+		TransferID:        resp.GetTransferID(),
+		FromTransactionID: resp.GetFromTransactionID(),
+		ToTransactionID:   resp.GetToTransactionID(),
+	}, nil
+}
+
+func mapAccountToProto(a model.Account) *cyberdatabase.Account {
+	return &cyberdatabase.Account{
+		ID:             a.ID,
+		Name:           a.Name,
+		Currency:       a.Currency,
+		OpeningBalance: a.OpeningBalance,
+		IsDefault:      a.IsDefault,
+	}
+}
+
+func (c *DBClient) RequestChatMessages(ctx context.Context, uid int64, q model.ChatHistoryQuery) ([]model.ChatMessage, error) {
+	resp, err := c.db.RequestChatMessages(ctx, &cyberdatabase.RequestChatMessagesRequest{
+		UserID: uid,
+		Limit:  q.Limit,
+		Before: q.Before,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6052, "grpc request chat messages failed")
+	}
+	messages := make([]model.ChatMessage, 0, len(resp.GetMessages()))
+	for _, m := range resp.GetMessages() {
+		messages = append(messages, model.ChatMessage{
+			ID:        m.GetID(),
+			Role:      m.GetRole(),
+			Content:   m.GetContent(),
+			CreatedAt: m.GetCreatedAt(),
+		})
+	}
+	return messages, nil
+}
+
+func (c *DBClient) AddCategory(ctx context.Context, uid int64, cat model.Category) (int64, error) {
+	resp, err := c.db.AddCategory(ctx, &cyberdatabase.AddCategoryRequest{
+		UserID:   uid,
+		Category: mapCategoryToProto(cat),
+	})
+	if err != nil {
+		return 0, grpcerror.Wrap(err, 6053, "grpc add category failed")
+	}
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) EditCategory(ctx context.Context, uid int64, cat model.Category) error {
+	_, err := c.db.EditCategory(ctx, &cyberdatabase.EditCategoryRequest{
+		UserID:   uid,
+		Category: mapCategoryToProto(cat),
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6054, "grpc edit category failed")
+	}
+	return nil
+}
+
+func (c *DBClient) DeleteCategory(ctx context.Context, uid, cid int64) error {
+	_, err := c.db.DeleteCategory(ctx, &cyberdatabase.DeleteCategoryRequest{
+		UserID: uid,
+		ID:     cid,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6055, "grpc delete category failed")
+	}
+	return nil
+}
+
+func (c *DBClient) RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, error) {
+	resp, err := c.db.RequestUserCategories(ctx, &cyberdatabase.RequestUserCategoriesRequest{UserID: uid})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6056, "grpc request categories failed")
+	}
+	categories := make([]model.Category, 0, len(resp.GetCategories()))
+	for _, cat := range resp.GetCategories() {
+		categories = append(categories, model.Category{
+			ID:       cat.GetID(),
+			Name:     cat.GetName(),
+			Icon:     cat.GetIcon(),
+			Color:    cat.GetColor(),
+			ParentID: cat.GetParentID(),
+			Type:     cat.GetType(),
+		})
+	}
+	return categories, nil
+}
+
+func mapCategoryToProto(c model.Category) *cyberdatabase.Category {
+	return &cyberdatabase.Category{
+		ID:       c.ID,
+		Name:     c.Name,
+		Icon:     c.Icon,
+		Color:    c.Color,
+		ParentID: c.ParentID,
+		Type:     c.Type,
+	}
+}
+
+func (c *DBClient) AddRule(ctx context.Context, uid int64, r model.Rule) (int64, error) {
+	resp, err := c.db.AddRule(ctx, &cyberdatabase.AddRuleRequest{
+		UserID: uid,
+		Rule:   mapRuleToProto(r),
+	})
+	if err != nil {
+		return 0, grpcerror.Wrap(err, 6057, "grpc add rule failed")
+	}
+	return resp.GetID(), nil
+}
+
+func (c *DBClient) EditRule(ctx context.Context, uid int64, r model.Rule) error {
+	_, err := c.db.EditRule(ctx, &cyberdatabase.EditRuleRequest{
+		UserID: uid,
+		Rule:   mapRuleToProto(r),
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6058, "grpc edit rule failed")
+	}
+	return nil
+}
+
+func (c *DBClient) DeleteRule(ctx context.Context, uid, rid int64) error {
+	_, err := c.db.DeleteRule(ctx, &cyberdatabase.DeleteRuleRequest{
+		UserID: uid,
+		ID:     rid,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6059, "grpc delete rule failed")
+	}
+	return nil
+}
+
+func (c *DBClient) RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, error) {
+	resp, err := c.db.RequestUserRules(ctx, &cyberdatabase.RequestUserRulesRequest{UserID: uid})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6060, "grpc request rules failed")
+	}
+	rules := make([]model.Rule, 0, len(resp.GetRules()))
+	for _, r := range resp.GetRules() {
+		rules = append(rules, model.Rule{
+			ID:                  r.GetID(),
+			Priority:            r.GetPriority(),
+			DescriptionContains: r.GetDescriptionContains(),
+			DescriptionRegex:    r.GetDescriptionRegex(),
+			AmountMin:           r.GetAmountMin(),
+			AmountMax:           r.GetAmountMax(),
+			Type:                r.GetType(),
+			Kategoria:           r.GetKategoria(),
+			Global:              r.GetGlobal(),
+		})
+	}
+	return rules, nil
+}
+
+func mapRuleToProto(r model.Rule) *cyberdatabase.Rule {
+	return &cyberdatabase.Rule{
+		ID:                  r.ID,
+		Priority:            r.Priority,
+		DescriptionContains: r.DescriptionContains,
+		DescriptionRegex:    r.DescriptionRegex,
+		AmountMin:           r.AmountMin,
+		AmountMax:           r.AmountMax,
+		Type:                r.Type,
+		Kategoria:           r.Kategoria,
+	}
+}
+
+func (c *DBClient) RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, error) {
+	resp, err := c.db.RequestCategoryCorrections(ctx, &cyberdatabase.RequestCategoryCorrectionsRequest{
+		UserID: uid,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6061, "grpc request category corrections failed")
+	}
+	corrections := make([]model.CategoryCorrection, 0, len(resp.GetCorrections()))
+	for _, cc := range resp.GetCorrections() {
+		corrections = append(corrections, model.CategoryCorrection{
+			ID:            cc.GetID(),
+			TransactionID: cc.GetTransactionID(),
+			Description:   cc.GetDescription(),
+			Type:          cc.GetType(),
+			Amount:        cc.GetAmount(),
+			OldKategoria:  cc.GetOldKategoria(),
+			NewKategoria:  cc.GetNewKategoria(),
+			CreatedAt:     cc.GetCreatedAt(),
+		})
+	}
+	return corrections, nil
+}
+
+func (c *DBClient) ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, error) {
+	resp, err := c.db.ClaimCategorizationJobs(ctx, &cyberdatabase.ClaimCategorizationJobsRequest{
+		Now:        now,
+		LeaseUntil: leaseUntil,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, grpcerror.Wrap(err, 6062, "grpc claim categorization jobs failed")
+	}
+	jobs := make([]model.CategorizationJob, 0, len(resp.GetJobs()))
+	for _, j := range resp.GetJobs() {
+		t := j.GetTransaction()
+		jobs = append(jobs, model.CategorizationJob{
+			ID:            j.GetID(),
+			UserID:        j.GetUserID(),
+			TransactionID: j.GetTransactionID(),
+			Attempts:      j.GetAttempts(),
+			Transaction: model.Transaction{
+				ID:              t.GetID(),
+				Date:            t.GetDate(),
+				Kategoria:       t.GetKategoria(),
+				Type:            t.GetType(),
+				Amount:          t.GetAmount(),
+				Description:     t.GetDescription(),
+				AccountID:       t.GetAccountID(),
+				Currency:        t.GetCurrency(),
+				CategoryPending: t.GetCategoryPending(),
+			},
+		})
+	}
+	return jobs, nil
+}
+
+// CompleteCategorizationJob reports whether the category was written, which
+// it is not when the user set one first or the job was claimed again.
+func (c *DBClient) CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, error) {
+	resp, err := c.db.CompleteCategorizationJob(ctx, &cyberdatabase.CompleteCategorizationJobRequest{
+		ID:        id,
+		Attempts:  attempts,
+		Kategoria: kategoria,
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6063, "grpc complete categorization job failed")
+	}
+	return resp.GetApplied(), nil
+}
+
+func (c *DBClient) FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) error {
+	_, err := c.db.FailCategorizationJob(ctx, &cyberdatabase.FailCategorizationJobRequest{
+		ID:       id,
+		Attempts: attempts,
+		Error:    errMes,
+		RetryAt:  retryAt,
+		Final:    final,
+	})
+	if err != nil {
+		return grpcerror.Wrap(err, 6064, "grpc fail categorization job failed")
+	}
+	return nil
+}
+
+// RequestRateLimit returns the counter of key for the user, with Version 0
+// when there is none yet.
+func (c *DBClient) RequestRateLimit(ctx context.Context, uid int64, key string) (model.RateLimitState, error) {
+	resp, err := c.db.RequestRateLimit(ctx, &cyberdatabase.RequestRateLimitRequest{UserID: uid, Key: key})
+	if err != nil {
+		return model.RateLimitState{}, grpcerror.Wrap(err, 6066, "grpc request rate limit failed")
+	}
+	st := resp.GetState()
+	return model.RateLimitState{
+		Tokens:    st.GetTokens(),
+		UpdatedAt: st.GetUpdatedAt(),
+		Day:       st.GetDay(),
+		Used:      st.GetUsed(),
+		Version:   st.GetVersion(),
+	}, nil
+}
+
+// SwapRateLimit stores s unless the counter changed since s.Version was
+// read, and reports whether it did.
+func (c *DBClient) SwapRateLimit(ctx context.Context, uid int64, key string, s model.RateLimitState) (bool, error) {
+	resp, err := c.db.SwapRateLimit(ctx, &cyberdatabase.SwapRateLimitRequest{
+		UserID: uid,
+		Key:    key,
+		State: &cyberdatabase.RateLimitState{
+			Tokens:    s.Tokens,
+			UpdatedAt: s.UpdatedAt,
+			Day:       s.Day,
+			Used:      s.Used,
+			Version:   s.Version,
+		},
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6074, "grpc swap rate limit failed")
+	}
+	return resp.GetSwapped(), nil
+}
+
+// StartRecategorization stores j as the user's new re-categorisation and
+// returns its id. It returns false when another one is still in progress and
+// has been saved since staleBefore.
+func (c *DBClient) StartRecategorization(ctx context.Context, uid int64, j model.Recategorization, staleBefore int64) (int64, bool, error) {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return 0, false, apperror.SystemError(err, 6071, "failed to encode recategorization")
+	}
+	resp, err := c.db.StartRecategorization(ctx, &cyberdatabase.StartRecategorizationRequest{
+		UserID:      uid,
+		Status:      j.Status,
+		Data:        data,
+		Now:         time.Now().UnixMilli(),
+		StaleBefore: staleBefore,
+	})
+	if err != nil {
+		return 0, false, grpcerror.Wrap(err, 6068, "grpc start recategorization failed")
+	}
+	return resp.GetID(), resp.GetStarted(), nil
+}
+
+// SaveRecategorization stores the state of job j.ID. With fromStatus set it
+// only does so while the stored job is in that status. It returns false when
+// nothing was stored.
+func (c *DBClient) SaveRecategorization(ctx context.Context, uid int64, j model.Recategorization, fromStatus string) (bool, error) {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return false, apperror.SystemError(err, 6072, "failed to encode recategorization")
+	}
+	resp, err := c.db.UpdateRecategorization(ctx, &cyberdatabase.UpdateRecategorizationRequest{
+		UserID:     uid,
+		ID:         j.ID,
+		FromStatus: fromStatus,
+		Status:     j.Status,
+		Data:       data,
+		Now:        time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return false, grpcerror.Wrap(err, 6069, "grpc update recategorization failed")
+	}
+	return resp.GetUpdated(), nil
+}
+
+func (c *DBClient) RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, error) {
+	resp, err := c.db.RequestRecategorization(ctx, &cyberdatabase.RequestRecategorizationRequest{UserID: uid})
+	if err != nil {
+		return model.Recategorization{}, false, grpcerror.Wrap(err, 6070, "grpc request recategorization failed")
+	}
+	if !resp.GetFound() {
+		return model.Recategorization{}, false, nil
+	}
+	var j model.Recategorization
+	if err := json.Unmarshal(resp.GetData(), &j); err != nil {
+		return model.Recategorization{}, false, apperror.SystemError(err, 6073, "failed to decode recategorization")
+	}
+	j.ID = resp.GetID()
+	j.Status = resp.GetStatus()
+	return j, true, nil
 }
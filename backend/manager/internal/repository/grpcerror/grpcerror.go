@@ -0,0 +1,54 @@
+package grpcerror
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Wrap turns a failed gRPC call into an application error with the HTTP
+// status matching its code and the app code of the call site. The gRPC
+// clients of the manager take their codes from 6000-6999, which no service
+// uses, so a logged code tells the call site. Client errors keep the message
+// the service sent;
+// server errors get message, so internals do not reach the user. The domain
+// and app code the service sent are kept in the logged error.
+func Wrap(err error, code int, message string) error {
+	st := status.Convert(err)
+	httpStatus := httpStatus(st.Code())
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetMetadata()["app_code"] != "" {
+			err = fmt.Errorf("%s %s: %w", info.GetDomain(), info.GetMetadata()["app_code"], err)
+		}
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		return apperror.New(err, httpStatus, code, message)
+	}
+	return apperror.New(err, httpStatus, code, st.Message())
+}
+
+func httpStatus(c codes.Code) int {
+	switch c {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	model "manager/internal/models"
 	"net/http"
@@ -28,17 +29,37 @@ func New(log *logrus.Logger, host string, port int) *MLClient {
 }
 
 type categorizeReq struct {
-	UserID      string              `json:"user_id"`
-	Transaction model.TransactionMl `json:"transaction"`
+	UserID      string                  `json:"user_id"`
+	Transaction model.TransactionMl     `json:"transaction"`
+	Categories  []string                `json:"categories"`
+	Examples    []model.CategoryExample `json:"examples,omitempty"`
 }
 
-type categorizeResp struct {
-	Kategoria string `json:"kategoria"`
+type categorizeBatchReq struct {
+	UserID       string                  `json:"user_id"`
+	Transactions []model.TransactionMl   `json:"transactions"`
+	Categories   []string                `json:"categories"`
+	Examples     []model.CategoryExample `json:"examples,omitempty"`
+}
+
+type categorizeBatchResp struct {
+	Results []model.CategoryPrediction `json:"results"`
+}
+
+type parseReq struct {
+	UserID   string `json:"user_id"`
+	Text     string `json:"text"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type parseResp struct {
+	Transactions []model.TransactionMl `json:"transactions"`
 }
 
 type chatReq struct {
 	UserID string `json:"user_id"`
 	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream,omitempty"`
 }
 
 type chatResp struct {
@@ -48,47 +69,156 @@ type chatResp struct {
 type adviceReq struct {
 	UserID       string `json:"user_id"`
 	Transactions string `json:"transactions"`
+	Stream       bool   `json:"stream,omitempty"`
+}
+
+type streamChunk struct {
+	Content string `json:"content"`
+	Done    bool   `json:"done"`
+	Error   string `json:"error"`
 }
 
 type adviceResp struct {
 	Advice string `json:"advice"`
 }
 
-func (c *MLClient) CategorizeTransaction(ctx context.Context, uid int64, t model.TransactionMl) (string, error) {
+func (c *MLClient) CategorizeTransaction(ctx context.Context, uid int64, t model.TransactionMl, categories []string, examples []model.CategoryExample) (model.CategoryPrediction, error) {
 	url := c.baseURL + "/api/categorize"
 
 	body := categorizeReq{
 		UserID:      fmt.Sprintf("%d", uid),
 		Transaction: t,
+		Categories:  categories,
+		Examples:    examples,
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return model.CategoryPrediction{}, apperror.SystemError(err, 5001, "failed to marshal ml request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return model.CategoryPrediction{}, apperror.SystemError(err, 5002, "failed to create ml request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return model.CategoryPrediction{}, apperror.SystemError(err, 5003, "failed to call ml service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return model.CategoryPrediction{}, busyError(resp)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return model.CategoryPrediction{}, apperror.SystemError(fmt.Errorf("ml service returned status %d", resp.StatusCode), 5004, "ml service error")
+	}
+
+	var result model.CategoryPrediction
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return model.CategoryPrediction{}, apperror.SystemError(err, 5005, "failed to decode ml response")
+	}
+
+	return result, nil
+}
+
+// CategorizeTransactions categorises ts in as few model calls as the ml
+// service can pack them into and returns one prediction per transaction.
+func (c *MLClient) CategorizeTransactions(ctx context.Context, uid int64, ts []model.TransactionMl, categories []string, examples []model.CategoryExample) ([]model.CategoryPrediction, error) {
+	url := c.baseURL + "/api/categorize/batch"
+
+	body := categorizeBatchReq{
+		UserID:       fmt.Sprintf("%d", uid),
+		Transactions: ts,
+		Categories:   categories,
+		Examples:     examples,
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, apperror.SystemError(err, 5025, "failed to marshal ml batch request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, apperror.SystemError(err, 5026, "failed to create ml batch request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, apperror.SystemError(err, 5027, "failed to call ml service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return nil, busyError(resp)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, apperror.SystemError(fmt.Errorf("ml service returned status %d", resp.StatusCode), 5028, "ml service error")
+	}
+
+	var result categorizeBatchResp
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, apperror.SystemError(err, 5029, "failed to decode ml batch response")
+	}
+	if len(result.Results) != len(ts) {
+		return nil, apperror.SystemError(fmt.Errorf("got %d results for %d transactions", len(result.Results), len(ts)), 5030, "ml batch response mismatch")
+	}
+
+	return result.Results, nil
+}
+
+// ParseTransactions extracts the transactions written in text, resolving
+// relative dates in the IANA zone tz.
+func (c *MLClient) ParseTransactions(ctx context.Context, uid int64, text, tz string) ([]model.TransactionMl, error) {
+	url := c.baseURL + "/api/parse"
+
+	body := parseReq{
+		UserID:   fmt.Sprintf("%d", uid),
+		Text:     text,
+		Timezone: tz,
 	}
 
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		return "", apperror.SystemError(err, 5001, "failed to marshal ml request")
+		return nil, apperror.SystemError(err, 5031, "failed to marshal ml parse request")
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return "", apperror.SystemError(err, 5002, "failed to create ml request")
+		return nil, apperror.SystemError(err, 5032, "failed to create ml parse request")
 	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return "", apperror.SystemError(err, 5003, "failed to call ml service")
+		return nil, apperror.SystemError(err, 5033, "failed to call ml service")
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusBadRequest {
+		var result struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&result)
+		return nil, apperror.BadRequestError(errors.New(result.Error), 5034, "invalid text or timezone")
+	}
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return nil, busyError(resp)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return "", apperror.SystemError(fmt.Errorf("ml service returned status %d", resp.StatusCode), 5004, "ml service error")
+		return nil, apperror.SystemError(fmt.Errorf("ml service returned status %d", resp.StatusCode), 5035, "ml service error")
 	}
 
-	var result categorizeResp
+	var result parseResp
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", apperror.SystemError(err, 5005, "failed to decode ml response")
+		return nil, apperror.SystemError(err, 5036, "failed to decode ml parse response")
 	}
 
-	return result.Kategoria, nil
+	return result.Transactions, nil
 }
 
 func (c *MLClient) Chat(ctx context.Context, uid int64, prompt string) (string, error) {
@@ -116,6 +246,9 @@ func (c *MLClient) Chat(ctx context.Context, uid int64, prompt string) (string,
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return "", busyError(resp)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", apperror.SystemError(fmt.Errorf("ml chat service returned status %d", resp.StatusCode), 5009, "ml chat service error")
 	}
@@ -153,6 +286,9 @@ func (c *MLClient) GetAdvice(ctx context.Context, uid int64, transactions string
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return "", busyError(resp)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", apperror.SystemError(fmt.Errorf("ml advice service returned status %d", resp.StatusCode), 5014, "ml advice service error")
 	}
@@ -185,3 +321,74 @@ func (c *MLClient) ClearContext(ctx context.Context, uid int64) error {
 
 	return nil
 }
+
+func (c *MLClient) ChatStream(ctx context.Context, uid int64, prompt string, onToken func(string) error) error {
+	return c.stream(ctx, "/api/chat", chatReq{
+		UserID: fmt.Sprintf("%d", uid),
+		Prompt: prompt,
+		Stream: true,
+	}, onToken)
+}
+
+func (c *MLClient) AdviceStream(ctx context.Context, uid int64, transactions string, onToken func(string) error) error {
+	return c.stream(ctx, "/api/advice", adviceReq{
+		UserID:       fmt.Sprintf("%d", uid),
+		Transactions: transactions,
+		Stream:       true,
+	}, onToken)
+}
+
+// stream reads the NDJSON answer of the ml service and hands every token to
+// onToken. The request is bound to ctx, so the generation stops as soon as
+// the caller goes away.
+func (c *MLClient) stream(ctx context.Context, path string, body any, onToken func(string) error) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return apperror.SystemError(err, 5019, "failed to marshal ml stream request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return apperror.SystemError(err, 5020, "failed to create ml stream request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return apperror.SystemError(err, 5021, "failed to call ml stream service")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		return busyError(resp)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return apperror.SystemError(fmt.Errorf("ml stream service returned status %d", resp.StatusCode), 5022, "ml stream service error")
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var chunk streamChunk
+		if err := dec.Decode(&chunk); err != nil {
+			return apperror.SystemError(err, 5023, "failed to decode ml stream")
+		}
+		if chunk.Error != "" {
+			return apperror.SystemError(errors.New(chunk.Error), 5024, "ml stream failed")
+		}
+		if chunk.Content != "" {
+			if err := onToken(chunk.Content); err != nil {
+				return err
+			}
+		}
+		if chunk.Done {
+			return nil
+		}
+	}
+}
+
+// busyError reports a request the ml service refused because the queue of
+// its model is full.
+func busyError(resp *http.Response) error {
+	err := fmt.Errorf("ml service busy, retry after %ss", resp.Header.Get("Retry-After"))
+	return apperror.New(err, http.StatusServiceUnavailable, 5037, "AI assistant is busy, try again later")
+}
@@ -2,27 +2,77 @@ package router
 
 import (
 	"context"
+	"crypto/hmac"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"manager/internal/export"
 	model "manager/internal/models"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
 type ServiceAPI interface {
 	AuthUser(ctx context.Context, initData string) (int64, error)
-	AddTransaction(ctx context.Context, uid int64, t model.TransactionMl) error
+	CreateSession(ctx context.Context, initData string) (model.Session, error)
+	RefreshSession(ctx context.Context, refreshToken string) (model.Session, error)
+	VerifySession(token string) (int64, error)
+	TakeRateLimit(ctx context.Context, uid int64, key string) (time.Duration, error)
+	AddTransaction(ctx context.Context, uid int64, t model.TransactionMl) (model.Categorization, *model.Budget, error)
 	DeleteTransaction(ctx context.Context, uid, tid int64) error
 	EditTransaction(ctx context.Context, uid int64, t model.Transaction) error
-	GetHistory(ctx context.Context, uid int64) ([]model.Transaction, error)
+	GetHistory(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, error)
 	Chat(ctx context.Context, uid int64, prompt string) (string, error)
 	GetFinancialAdvice(ctx context.Context, uid int64) (string, error)
+	ChatStream(ctx context.Context, uid int64, prompt string, onToken func(string) error) error
+	GetFinancialAdviceStream(ctx context.Context, uid int64, onToken func(string) error) error
 	ClearChatContext(ctx context.Context, uid int64) error
+	GetChatHistory(ctx context.Context, uid int64, q model.ChatHistoryQuery) ([]model.ChatMessage, error)
+	GetBudgets(ctx context.Context, uid int64) ([]model.Budget, error)
+	SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, error)
+	DeleteBudget(ctx context.Context, uid, bid int64) error
+	GetRecurring(ctx context.Context, uid int64) ([]model.Recurring, error)
+	AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, error)
+	SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) error
+	DeleteRecurring(ctx context.Context, uid, rid int64) error
+	ImportStatement(ctx context.Context, uid int64, format string, accountID int64, data []byte) (model.ImportReport, error)
+	ExportTransactions(ctx context.Context, uid int64, format string, q model.TransactionQuery, w io.Writer) error
+	GetStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, error)
+	GetAccounts(ctx context.Context, uid int64) ([]model.Account, error)
+	AddAccount(ctx context.Context, uid int64, a model.Account) (int64, error)
+	EditAccount(ctx context.Context, uid int64, a model.Account) error
+	DeleteAccount(ctx context.Context, uid, aid int64) error
+	Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, error)
+	GetCategories(ctx context.Context, uid int64) ([]model.Category, error)
+	AddCategory(ctx context.Context, uid int64, c model.Category) (int64, error)
+	EditCategory(ctx context.Context, uid int64, c model.Category) error
+	DeleteCategory(ctx context.Context, uid, cid int64) error
+	GetRules(ctx context.Context, uid int64) ([]model.Rule, error)
+	AddRule(ctx context.Context, uid int64, r model.Rule) (int64, error)
+	EditRule(ctx context.Context, uid int64, r model.Rule) error
+	DeleteRule(ctx context.Context, uid, rid int64) error
+	StartRecategorization(ctx context.Context, uid int64, req model.RecategorizationRequest) (int64, error)
+	GetRecategorization(ctx context.Context, uid int64) (model.Recategorization, error)
+	ApplyRecategorization(ctx context.Context, uid, id int64) error
+	ParseTransactions(ctx context.Context, uid int64, req model.ParseRequest) ([]model.ParsedTransaction, error)
+	GetTools() []model.Tool
+	CallTool(ctx context.Context, uid int64, name string, args json.RawMessage) (any, error)
 }
 
+const maxStatementSize = 10 << 20
+
+// ToolsSecretHeader carries TOOLS_SECRET on the requests of the ml service.
+const ToolsSecretHeader = "X-Tools-Secret"
+
 type Handler struct {
 	log     *logrus.Logger
 	service ServiceAPI
@@ -36,6 +86,9 @@ func New(log *logrus.Logger, service ServiceAPI) *Handler {
 }
 
 func (h *Handler) RouterRegister(r *gin.Engine) {
+	r.POST("/webapp/session", h.createSession)
+	r.POST("/webapp/session/refresh", h.refreshSession)
+
 	api := r.Group("/webapp")
 	api.Use(h.authUser)
 	{
@@ -43,22 +96,63 @@ func (h *Handler) RouterRegister(r *gin.Engine) {
 		api.POST("/addt", h.addTransaction)
 		api.POST("/deletet", h.deleteTransaction)
 		api.POST("/updatet", h.updateTransaction)
-		api.POST("/chat", h.chat)
-		api.GET("/advice", h.requestAdvice)
+		api.POST("/chat", h.rateLimit(model.RateLimitChat), h.chat)
+		api.GET("/advice", h.rateLimit(model.RateLimitAdvice), h.requestAdvice)
+		api.POST("/chat/stream", h.rateLimit(model.RateLimitChat), h.chatStream)
+		api.GET("/advice/stream", h.rateLimit(model.RateLimitAdvice), h.requestAdviceStream)
 		api.POST("/clear-context", h.clearContext)
+		api.GET("/chat/history", h.requestChatHistory)
+		api.GET("/budgets", h.requestBudgets)
+		api.POST("/budgets", h.addBudget)
+		api.POST("/budgets/update", h.updateBudget)
+		api.POST("/budgets/delete", h.deleteBudget)
+		api.GET("/recurring", h.requestRecurring)
+		api.POST("/recurring", h.addRecurring)
+		api.POST("/recurring/pause", h.pauseRecurring)
+		api.POST("/recurring/delete", h.deleteRecurring)
+		api.POST("/import", h.importStatement)
+		api.GET("/export", h.exportTransactions)
+		api.GET("/stats", h.requestStats)
+		api.GET("/accounts", h.requestAccounts)
+		api.POST("/accounts", h.addAccount)
+		api.POST("/accounts/update", h.updateAccount)
+		api.POST("/accounts/delete", h.deleteAccount)
+		api.POST("/accounts/transfer", h.transfer)
+		api.GET("/categories", h.requestCategories)
+		api.POST("/categories", h.addCategory)
+		api.POST("/categories/update", h.updateCategory)
+		api.POST("/categories/delete", h.deleteCategory)
+		api.GET("/rules", h.requestRules)
+		api.POST("/rules", h.addRule)
+		api.POST("/rules/update", h.updateRule)
+		api.POST("/rules/delete", h.deleteRule)
+		api.POST("/recategorize", h.startRecategorization)
+		api.GET("/recategorize", h.requestRecategorization)
+		api.POST("/recategorize/apply", h.applyRecategorization)
+		api.POST("/parse", h.rateLimit(model.RateLimitParse), h.parseTransactions)
 	}
 }
 
 func (h *Handler) requestDataHistory(c *gin.Context) {
 	uid := c.GetInt64("uid")
 
-	history, err := h.service.GetHistory(c.Request.Context(), uid)
+	var q model.TransactionQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4007, "invalid query parameters"))
+		return
+	}
+	if q.Limit < 0 {
+		_ = c.Error(apperror.BadRequestError(errors.New("negative limit"), 4008, "limit must not be negative"))
+		return
+	}
+
+	page, err := h.service.GetHistory(c.Request.Context(), uid, q)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": history})
+	c.JSON(http.StatusOK, page)
 }
 
 func (h *Handler) addTransaction(c *gin.Context) {
@@ -70,12 +164,14 @@ func (h *Handler) addTransaction(c *gin.Context) {
 		return
 	}
 
-	if err := h.service.AddTransaction(c.Request.Context(), uid, t); err != nil {
+	categorization, budget, err := h.service.AddTransaction(c.Request.Context(), uid, t)
+	if err != nil {
 		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"status": "success"})
+	pending := categorization.Source == model.CategorizationPending
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "budget": budget, "budget_pending": pending, "categorization": categorization})
 }
 
 func (h *Handler) deleteTransaction(c *gin.Context) {
@@ -113,41 +209,107 @@ func (h *Handler) updateTransaction(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"status": "success"})
 }
 
+// authUser aborts on failure, so no handler runs without a user.
 func (h *Handler) authUser(c *gin.Context) {
+	scheme, authData, err := credentials(c)
+	if err != nil {
+		_ = c.Error(err)
+		c.Abort()
+		return
+	}
+
+	var uid int64
+	if strings.EqualFold(scheme, "Bearer") {
+		uid, err = h.service.VerifySession(authData)
+	} else {
+		uid, err = h.service.AuthUser(c.Request.Context(), authData)
+	}
+	if err != nil {
+		_ = c.Error(err)
+		c.Abort()
+		return
+	}
+	c.Set("uid", uid)
+	c.Next()
+}
+
+// rateLimit refuses the request with 429 and Retry-After in seconds when the
+// user is over the limit of key.
+func (h *Handler) rateLimit(key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		retryAfter, err := h.service.TakeRateLimit(c.Request.Context(), c.GetInt64("uid"), key)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		if retryAfter > 0 {
+			c.Header("Retry-After", strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10))
+			_ = c.Error(apperror.CustomError(fmt.Errorf("%s rate limit, retry after %s", key, retryAfter), http.StatusTooManyRequests, 4043, "Too many requests, try again later"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// credentials reads the Authorization header, "tma <initData>" or
+// "Bearer <access token>", and falls back to initData in the query string.
+func credentials(c *gin.Context) (string, string, error) {
 	authHeader := c.GetHeader("Authorization")
-	var authData string
 	if authHeader != "" {
 		authParts := strings.Split(authHeader, " ")
-		if len(authParts) != 2 {
-			appErr := apperror.BadRequestError(errors.New("invalid auth header"), 4004, "Invalid authorization header format. Expected 'tma <data>'.")
-			_ = c.Error(appErr)
-			return
-		}
-		authData = authParts[1]
-	} else {
-		raw := c.Request.URL.RawQuery
-		const tmaPrefix = "tma"
-		idx := strings.Index(raw, tmaPrefix)
-		if idx == -1 {
-			authData = ""
-		} else {
-			authData = raw[idx+len(tmaPrefix)+1:]
+		if len(authParts) != 2 || authParts[1] == "" {
+			return "", "", apperror.BadRequestError(errors.New("invalid auth header"), 4004, "Invalid authorization header format. Expected 'tma <data>' or 'Bearer <token>'.")
 		}
+		return authParts[0], authParts[1], nil
+	}
+
+	raw := c.Request.URL.RawQuery
+	const tmaPrefix = "tma"
+	idx := strings.Index(raw, tmaPrefix)
+	if idx == -1 || len(raw) <= idx+len(tmaPrefix)+1 {
+		return "", "", apperror.BadRequestError(errors.New("missing auth header"), 4005, "Missing authorization header")
 	}
+	return tmaPrefix, raw[idx+len(tmaPrefix)+1:], nil
+}
 
-	if authData == "" {
-		err := apperror.BadRequestError(errors.New("missing auth header"), 4005, "Missing authorization header")
+func (h *Handler) createSession(c *gin.Context) {
+	scheme, initData, err := credentials(c)
+	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+	if strings.EqualFold(scheme, "Bearer") {
+		_ = c.Error(apperror.BadRequestError(errors.New("bearer token instead of initData"), 4041, "A session is created from Telegram initData"))
+		return
+	}
 
-	uid, err := h.service.AuthUser(c.Request.Context(), authData)
+	sess, err := h.service.CreateSession(c.Request.Context(), initData)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
-	c.Set("uid", uid)
-	c.Next()
+
+	c.JSON(http.StatusCreated, sess)
+}
+
+func (h *Handler) refreshSession(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4042, "invalid json body"))
+		return
+	}
+
+	sess, err := h.service.RefreshSession(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sess)
 }
 
 func (h *Handler) chat(c *gin.Context) {
@@ -182,6 +344,76 @@ func (h *Handler) requestAdvice(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"advice": advice})
 }
 
+func (h *Handler) chatStream(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		Prompt string `json:"message"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4028, "invalid json body"))
+		return
+	}
+
+	streamTokens(c, func(onToken func(string) error) error {
+		return h.service.ChatStream(c.Request.Context(), uid, req.Prompt, onToken)
+	})
+}
+
+func (h *Handler) requestAdviceStream(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	streamTokens(c, func(onToken func(string) error) error {
+		return h.service.GetFinancialAdviceStream(c.Request.Context(), uid, onToken)
+	})
+}
+
+// streamTokens relays the tokens of produce to the client as Server-Sent
+// Events: "token" for every piece of the answer, then "done" or "error". An
+// error before the first token still becomes a regular JSON error response.
+func streamTokens(c *gin.Context, produce func(onToken func(string) error) error) {
+	onToken := func(token string) error {
+		startEventStream(c)
+		c.SSEvent("token", gin.H{"content": token})
+		c.Writer.Flush()
+		return c.Request.Context().Err()
+	}
+
+	err := produce(onToken)
+	if err != nil && !c.Writer.Written() {
+		_ = c.Error(err)
+		return
+	}
+	if c.Request.Context().Err() != nil {
+		return
+	}
+	if err != nil {
+		logger.LogOnError(err, "Stream interrupted")
+		var appErr *apperror.AppError
+		if !errors.As(err, &appErr) {
+			appErr = apperror.SystemError(err, 9404, "")
+		}
+		c.SSEvent("error", gin.H{"message": appErr.Message, "app_code": appErr.AppCode})
+		c.Writer.Flush()
+		return
+	}
+	startEventStream(c)
+	c.SSEvent("done", gin.H{})
+	c.Writer.Flush()
+}
+
+func startEventStream(c *gin.Context) {
+	if c.Writer.Written() {
+		return
+	}
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Keeps reverse proxies such as nginx from buffering the whole answer.
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+}
+
 func (h *Handler) clearContext(c *gin.Context) {
 	uid := c.GetInt64("uid")
 
@@ -192,3 +424,618 @@ func (h *Handler) clearContext(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"status": "context cleared"})
 }
+
+func (h *Handler) requestChatHistory(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var q model.ChatHistoryQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4027, "invalid query parameters"))
+		return
+	}
+
+	messages, err := h.service.GetChatHistory(c.Request.Context(), uid, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": messages})
+}
+
+func (h *Handler) requestBudgets(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	budgets, err := h.service.GetBudgets(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": budgets})
+}
+
+func (h *Handler) addBudget(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var b model.Budget
+	if err := c.BindJSON(&b); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4009, "invalid json body"))
+		return
+	}
+	b.ID = 0
+
+	id, err := h.service.SetBudget(c.Request.Context(), uid, b)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) updateBudget(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var b model.Budget
+	if err := c.BindJSON(&b); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4010, "invalid json body"))
+		return
+	}
+	if b.ID == 0 {
+		_ = c.Error(apperror.BadRequestError(errors.New("empty budget id"), 4011, "budget id is required"))
+		return
+	}
+
+	if _, err := h.service.SetBudget(c.Request.Context(), uid, b); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) deleteBudget(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4012, "invalid json body"))
+		return
+	}
+
+	if err := h.service.DeleteBudget(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) requestRecurring(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	recurring, err := h.service.GetRecurring(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": recurring})
+}
+
+func (h *Handler) addRecurring(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var r model.Recurring
+	if err := c.BindJSON(&r); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4013, "invalid json body"))
+		return
+	}
+
+	id, err := h.service.AddRecurring(c.Request.Context(), uid, r)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) pauseRecurring(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID     int64 `json:"id"`
+		Paused bool  `json:"paused"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4014, "invalid json body"))
+		return
+	}
+
+	if err := h.service.SetRecurringPaused(c.Request.Context(), uid, req.ID, req.Paused); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) deleteRecurring(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4015, "invalid json body"))
+		return
+	}
+
+	if err := h.service.DeleteRecurring(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) importStatement(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxStatementSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4016, "statement file is required"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4017, "failed to open statement file"))
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4018, "failed to read statement file"))
+		return
+	}
+
+	var accountID int64
+	if raw := c.PostForm("account_id"); raw != "" {
+		accountID, err = strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			_ = c.Error(apperror.BadRequestError(err, 4021, "invalid account id"))
+			return
+		}
+	}
+
+	report, err := h.service.ImportStatement(c.Request.Context(), uid, c.PostForm("format"), accountID, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *Handler) exportTransactions(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var q model.TransactionQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4019, "invalid query parameters"))
+		return
+	}
+	format := strings.ToLower(c.DefaultQuery("format", export.FormatCSV))
+
+	w := &attachmentWriter{
+		c:           c,
+		contentType: export.ContentType(format),
+		filename:    fmt.Sprintf("transactions-%s.%s", time.Now().Format("2006-01-02"), format),
+	}
+	err := h.service.ExportTransactions(c.Request.Context(), uid, format, q, w)
+	if err == nil {
+		return
+	}
+	if !c.Writer.Written() {
+		_ = c.Error(err)
+		return
+	}
+	logger.LogOnError(err, fmt.Sprintf("Export for user %d interrupted", uid))
+}
+
+// attachmentWriter sends the download headers with the first chunk of the
+// body, so an error before any data is produced still becomes a JSON error
+// response instead of an empty file.
+type attachmentWriter struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.c.Writer.Written() {
+		w.c.Header("Content-Type", w.contentType)
+		w.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
+
+func (h *Handler) requestStats(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var q model.StatsQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4020, "invalid query parameters"))
+		return
+	}
+
+	stats, err := h.service.GetStats(c.Request.Context(), uid, q)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+func (h *Handler) requestAccounts(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	accounts, err := h.service.GetAccounts(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": accounts})
+}
+
+func (h *Handler) addAccount(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var a model.Account
+	if err := c.BindJSON(&a); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4022, "invalid json body"))
+		return
+	}
+	a.ID = 0
+
+	id, err := h.service.AddAccount(c.Request.Context(), uid, a)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) updateAccount(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var a model.Account
+	if err := c.BindJSON(&a); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4023, "invalid json body"))
+		return
+	}
+	if a.ID == 0 {
+		_ = c.Error(apperror.BadRequestError(errors.New("empty account id"), 4024, "account id is required"))
+		return
+	}
+
+	if err := h.service.EditAccount(c.Request.Context(), uid, a); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) deleteAccount(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4025, "invalid json body"))
+		return
+	}
+
+	if err := h.service.DeleteAccount(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) transfer(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var t model.Transfer
+	if err := c.BindJSON(&t); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4026, "invalid json body"))
+		return
+	}
+
+	res, err := h.service.Transfer(c.Request.Context(), uid, t)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *Handler) requestCategories(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	categories, err := h.service.GetCategories(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": categories})
+}
+
+func (h *Handler) addCategory(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var cat model.Category
+	if err := c.BindJSON(&cat); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4029, "invalid json body"))
+		return
+	}
+	cat.ID = 0
+
+	id, err := h.service.AddCategory(c.Request.Context(), uid, cat)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) updateCategory(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var cat model.Category
+	if err := c.BindJSON(&cat); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4030, "invalid json body"))
+		return
+	}
+	if cat.ID == 0 {
+		_ = c.Error(apperror.BadRequestError(errors.New("empty category id"), 4031, "category id is required"))
+		return
+	}
+
+	if err := h.service.EditCategory(c.Request.Context(), uid, cat); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) deleteCategory(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4032, "invalid json body"))
+		return
+	}
+
+	if err := h.service.DeleteCategory(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) requestRules(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	rules, err := h.service.GetRules(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": rules})
+}
+
+func (h *Handler) addRule(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var r model.Rule
+	if err := c.BindJSON(&r); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4033, "invalid json body"))
+		return
+	}
+	r.ID = 0
+
+	id, err := h.service.AddRule(c.Request.Context(), uid, r)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) updateRule(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var r model.Rule
+	if err := c.BindJSON(&r); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4034, "invalid json body"))
+		return
+	}
+	if r.ID == 0 {
+		_ = c.Error(apperror.BadRequestError(errors.New("empty rule id"), 4035, "rule id is required"))
+		return
+	}
+
+	if err := h.service.EditRule(c.Request.Context(), uid, r); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) deleteRule(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4036, "invalid json body"))
+		return
+	}
+
+	if err := h.service.DeleteRule(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "success"})
+}
+
+func (h *Handler) startRecategorization(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req model.RecategorizationRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4037, "invalid json body"))
+		return
+	}
+
+	id, err := h.service.StartRecategorization(c.Request.Context(), uid, req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"status": "success", "id": id})
+}
+
+func (h *Handler) requestRecategorization(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	job, err := h.service.GetRecategorization(c.Request.Context(), uid)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+func (h *Handler) applyRecategorization(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4038, "invalid json body"))
+		return
+	}
+
+	if err := h.service.ApplyRecategorization(c.Request.Context(), uid, req.ID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"status": "success"})
+}
+
+func (h *Handler) parseTransactions(c *gin.Context) {
+	uid := c.GetInt64("uid")
+
+	var req model.ParseRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4040, "invalid json body"))
+		return
+	}
+
+	transactions, err := h.service.ParseTransactions(c.Request.Context(), uid, req)
+	var appErr *apperror.AppError
+	if err != nil && len(transactions) > 0 && errors.As(err, &appErr) {
+		// The transactions added before the failure are listed, so a retry
+		// can leave them out instead of adding them twice.
+		logger.LogOnError(err, fmt.Sprintf("Failed to add parsed transactions of user %d", uid))
+		c.JSON(appErr.HTTPStatus, gin.H{"message": appErr.Message, "app_code": appErr.AppCode, "transactions": transactions})
+		return
+	}
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if req.Insert {
+		status = http.StatusCreated
+	}
+	c.JSON(status, gin.H{"status": "success", "transactions": transactions})
+}
+
+// ToolsRegister serves the chat tools to the ml service. The routes trust the
+// user_id they are given, so a caller must know the shared secret, and the
+// port must still only be reachable inside the network.
+func (h *Handler) ToolsRegister(r *gin.Engine, secret string) {
+	tools := r.Group("/tools", toolsAuth(secret))
+	tools.GET("", h.requestTools)
+	tools.POST("/call", h.callTool)
+}
+
+// toolsAuth checks the ToolsSecretHeader of the ml service. An empty secret
+// is only allowed by the local config and lets every request through.
+func toolsAuth(secret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if secret != "" && !hmac.Equal([]byte(c.GetHeader(ToolsSecretHeader)), []byte(secret)) {
+			_ = c.Error(apperror.CustomError(errors.New("invalid tools secret"), http.StatusUnauthorized, 4044, "Unauthorized"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func (h *Handler) requestTools(c *gin.Context) {
+	c.JSON(http.StatusOK, h.service.GetTools())
+}
+
+func (h *Handler) callTool(c *gin.Context) {
+	var req struct {
+		UserID    int64           `json:"user_id" binding:"required"`
+		Name      string          `json:"name" binding:"required"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(apperror.BadRequestError(err, 4039, "invalid json body"))
+		return
+	}
+
+	result, err := h.service.CallTool(c.Request.Context(), req.UserID, req.Name, req.Arguments)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": result})
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	model "manager/internal/models"
+	"regexp"
+	"strings"
+)
+
+// Engine evaluates categorisation rules in the order they were given and
+// returns the first one that matches.
+type Engine struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	model.Rule
+	contains string
+	regex    *regexp.Regexp
+}
+
+// New compiles rules. Description conditions are case-insensitive; a rule
+// with an invalid regex never matches.
+func New(rules []model.Rule) *Engine {
+	e := &Engine{rules: make([]compiledRule, 0, len(rules))}
+	for _, r := range rules {
+		c := compiledRule{Rule: r, contains: strings.ToLower(r.DescriptionContains)}
+		if r.DescriptionRegex != "" {
+			re, err := regexp.Compile("(?i)" + r.DescriptionRegex)
+			if err != nil {
+				continue
+			}
+			c.regex = re
+		}
+		e.rules = append(e.rules, c)
+	}
+	return e
+}
+
+func (e *Engine) Match(t model.TransactionMl) (model.Rule, bool) {
+	description := strings.ToLower(t.Description)
+	for _, r := range e.rules {
+		if r.matches(t, description) {
+			return r.Rule, true
+		}
+	}
+	return model.Rule{}, false
+}
+
+func (r compiledRule) matches(t model.TransactionMl, description string) bool {
+	if r.Type != "" && r.Type != t.Type {
+		return false
+	}
+	if r.AmountMin != 0 && t.Amount < r.AmountMin {
+		return false
+	}
+	if r.AmountMax != 0 && t.Amount > r.AmountMax {
+		return false
+	}
+	if r.contains != "" && !strings.Contains(description, r.contains) {
+		return false
+	}
+	if r.regex != nil && !r.regex.MatchString(t.Description) {
+		return false
+	}
+	return true
+}
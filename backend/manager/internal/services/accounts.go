@@ -0,0 +1,30 @@
+package httpservice
+
+import (
+	"context"
+	model "manager/internal/models"
+	"time"
+)
+
+func (s *ManagerService) GetAccounts(ctx context.Context, uid int64) ([]model.Account, error) {
+	return s.db.RequestUserAccounts(ctx, uid)
+}
+
+func (s *ManagerService) AddAccount(ctx context.Context, uid int64, a model.Account) (int64, error) {
+	return s.db.AddAccount(ctx, uid, a)
+}
+
+func (s *ManagerService) EditAccount(ctx context.Context, uid int64, a model.Account) error {
+	return s.db.EditAccount(ctx, uid, a)
+}
+
+func (s *ManagerService) DeleteAccount(ctx context.Context, uid, aid int64) error {
+	return s.db.DeleteAccount(ctx, uid, aid)
+}
+
+func (s *ManagerService) Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, error) {
+	if t.Date == 0 {
+		t.Date = time.Now().UnixMilli()
+	}
+	return s.db.Transfer(ctx, uid, t)
+}
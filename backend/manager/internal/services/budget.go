@@ -0,0 +1,53 @@
+package httpservice
+
+import (
+	"context"
+	"fmt"
+	model "manager/internal/models"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+func (s *ManagerService) GetBudgets(ctx context.Context, uid int64) ([]model.Budget, error) {
+	start, end := monthBounds(time.Now().UnixMilli(), s.budgetLocation)
+	return s.db.RequestUserBudgets(ctx, uid, start, end, nil, 0)
+}
+
+func (s *ManagerService) SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, error) {
+	return s.db.SetBudget(ctx, uid, b)
+}
+
+func (s *ManagerService) DeleteBudget(ctx context.Context, uid, bid int64) error {
+	return s.db.DeleteBudget(ctx, uid, bid)
+}
+
+// checkBudget reports the state of the budget covering t after it was added and
+// notifies the user in Telegram when t is the expense that crossed the limit.
+func (s *ManagerService) checkBudget(ctx context.Context, uid int64, t model.Transaction) *model.Budget {
+	start, end := monthBounds(t.Date, s.budgetLocation)
+	budgets, err := s.db.RequestUserBudgets(ctx, uid, start, end, []string{t.Kategoria}, t.ID)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to check budget for category %s", t.Kategoria))
+		return nil
+	}
+	if len(budgets) == 0 {
+		return nil
+	}
+	b := budgets[0]
+	if b.Exceeded && b.Added > 0 && b.Spent-b.Added <= b.Limit {
+		text := fmt.Sprintf("Превышен месячный бюджет по категории %s: потрачено %d из %d RUB.", b.Kategoria, b.Spent, b.Limit)
+		if err := s.bot.SendMessage(ctx, uid, text); err != nil {
+			logger.LogOnError(err, fmt.Sprintf("Failed to notify user %d about overspend", uid))
+		}
+	}
+	return &b
+}
+
+// monthBounds returns the [start, end) range in unix milliseconds of the
+// calendar month in loc containing ts.
+func monthBounds(ts int64, loc *time.Location) (int64, int64) {
+	t := time.UnixMilli(ts).In(loc)
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	return start.UnixMilli(), start.AddDate(0, 1, 0).UnixMilli()
+}
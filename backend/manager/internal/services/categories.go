@@ -0,0 +1,276 @@
+package httpservice
+
+import (
+	"context"
+	"fmt"
+	"manager/internal/learning"
+	model "manager/internal/models"
+	"manager/internal/rules"
+
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	// correctionsWindow is how many of the latest corrections are considered.
+	correctionsWindow = 200
+	maxExamples       = 5
+	// maxBatchExamples caps the examples shared by a whole batch.
+	maxBatchExamples = 20
+	// mlBatchLimit is the most transactions the ml service takes per request.
+	mlBatchLimit = 500
+	// autoRuleThreshold is how many times a merchant has to be corrected to
+	// the same category before a rule is created for it.
+	autoRuleThreshold = 3
+)
+
+func (s *ManagerService) GetCategories(ctx context.Context, uid int64) ([]model.Category, error) {
+	return s.db.RequestUserCategories(ctx, uid)
+}
+
+func (s *ManagerService) AddCategory(ctx context.Context, uid int64, c model.Category) (int64, error) {
+	return s.db.AddCategory(ctx, uid, c)
+}
+
+func (s *ManagerService) EditCategory(ctx context.Context, uid int64, c model.Category) error {
+	return s.db.EditCategory(ctx, uid, c)
+}
+
+func (s *ManagerService) DeleteCategory(ctx context.Context, uid, cid int64) error {
+	return s.db.DeleteCategory(ctx, uid, cid)
+}
+
+func (s *ManagerService) GetRules(ctx context.Context, uid int64) ([]model.Rule, error) {
+	return s.db.RequestUserRules(ctx, uid)
+}
+
+func (s *ManagerService) AddRule(ctx context.Context, uid int64, r model.Rule) (int64, error) {
+	return s.db.AddRule(ctx, uid, r)
+}
+
+func (s *ManagerService) EditRule(ctx context.Context, uid int64, r model.Rule) error {
+	return s.db.EditRule(ctx, uid, r)
+}
+
+func (s *ManagerService) DeleteRule(ctx context.Context, uid, rid int64) error {
+	return s.db.DeleteRule(ctx, uid, rid)
+}
+
+// categorizer holds what categorising the transactions of one user needs, so
+// a batch loads it once.
+type categorizer struct {
+	uid         int64
+	categories  []model.Category
+	rules       *rules.Engine
+	corrections []model.CategoryCorrection
+}
+
+// newCategorizer loads the user's categories, rules and latest corrections. Failures are only
+// logged; transactions are then categorised with what is available.
+func (s *ManagerService) newCategorizer(ctx context.Context, uid int64) categorizer {
+	categories, err := s.db.RequestUserCategories(ctx, uid)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to get categories of user %d", uid))
+	}
+	userRules, err := s.db.RequestUserRules(ctx, uid)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to get rules of user %d", uid))
+	}
+	corrections, err := s.db.RequestCategoryCorrections(ctx, uid, correctionsWindow)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to get category corrections of user %d", uid))
+	}
+	return categorizer{
+		uid:         uid,
+		categories:  categories,
+		rules:       rules.New(applicableRules(userRules, categories)),
+		corrections: corrections,
+	}
+}
+
+// categorize is tryCategorize that leaves the category empty when the ml
+// service fails.
+func (s *ManagerService) categorize(ctx context.Context, c categorizer, t model.TransactionMl) model.Categorization {
+	categorization, err := s.tryCategorize(ctx, c, t)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to categorize transaction %v", t))
+		return model.Categorization{}
+	}
+	return categorization
+}
+
+// tryCategorize applies the first matching rule. Only transactions no rule
+// matches go to the ml service, which picks among the user's categories of
+// the same type, or among all of them if the user has none of that type, and
+// sees the user's corrections of similar transactions as examples. The
+// category stays empty if the user has no categories.
+func (s *ManagerService) tryCategorize(ctx context.Context, c categorizer, t model.TransactionMl) (model.Categorization, error) {
+	if categorization, ok := c.matchRule(t); ok {
+		return categorization, nil
+	}
+	names := categoryNames(c.categories, t.Type)
+	if len(names) == 0 {
+		names = categoryNames(c.categories, "")
+	}
+	if len(names) == 0 {
+		return model.Categorization{}, nil
+	}
+	examples := learning.Examples(c.corrections, t, names, maxExamples)
+	prediction, err := s.ml.CategorizeTransaction(ctx, c.uid, t, names, examples)
+	if err != nil {
+		return model.Categorization{}, err
+	}
+	return model.Categorization{
+		Kategoria:  prediction.Kategoria,
+		Source:     model.CategorizedByModel,
+		Confidence: prediction.Confidence,
+		Reason:     prediction.Reason,
+	}, nil
+}
+
+// categorizeAll categorises ts like tryCategorize, but sends the transactions
+// no rule matches to the ml service in batches, one per transaction type, with
+// the examples of all of them. When the ml service fails the result still
+// holds the rule matches.
+func (s *ManagerService) categorizeAll(ctx context.Context, c categorizer, ts []model.TransactionMl) ([]model.Categorization, error) {
+	result := make([]model.Categorization, len(ts))
+	byType := make(map[string][]int)
+	var types []string
+	for i, t := range ts {
+		if categorization, ok := c.matchRule(t); ok {
+			result[i] = categorization
+			continue
+		}
+		if _, ok := byType[t.Type]; !ok {
+			types = append(types, t.Type)
+		}
+		byType[t.Type] = append(byType[t.Type], i)
+	}
+	for _, typ := range types {
+		names := categoryNames(c.categories, typ)
+		if len(names) == 0 {
+			names = categoryNames(c.categories, "")
+		}
+		if len(names) == 0 {
+			continue
+		}
+		indexes := byType[typ]
+		for start := 0; start < len(indexes); start += mlBatchLimit {
+			chunk := indexes[start:min(start+mlBatchLimit, len(indexes))]
+			batch := make([]model.TransactionMl, len(chunk))
+			for j, i := range chunk {
+				batch[j] = ts[i]
+			}
+			predictions, err := s.ml.CategorizeTransactions(ctx, c.uid, batch, names, batchExamples(c.corrections, batch, names))
+			if err != nil {
+				return result, err
+			}
+			for j, i := range chunk {
+				result[i] = model.Categorization{
+					Kategoria:  predictions[j].Kategoria,
+					Source:     model.CategorizedByModel,
+					Confidence: predictions[j].Confidence,
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// batchExamples merges the examples of every transaction of the batch.
+func batchExamples(corrections []model.CategoryCorrection, batch []model.TransactionMl, names []string) []model.CategoryExample {
+	var examples []model.CategoryExample
+	seen := make(map[string]bool)
+	for _, t := range batch {
+		for _, ex := range learning.Examples(corrections, t, names, maxExamples) {
+			if seen[ex.Description] {
+				continue
+			}
+			seen[ex.Description] = true
+			examples = append(examples, ex)
+			if len(examples) == maxBatchExamples {
+				return examples
+			}
+		}
+	}
+	return examples
+}
+
+func (c categorizer) matchRule(t model.TransactionMl) (model.Categorization, bool) {
+	r, ok := c.rules.Match(t)
+	if !ok {
+		return model.Categorization{}, false
+	}
+	return model.Categorization{Kategoria: r.Kategoria, Source: model.CategorizedByRule, RuleID: r.ID}, true
+}
+
+// applicableRules drops global rules for categories the user does not have.
+func applicableRules(rs []model.Rule, categories []model.Category) []model.Rule {
+	var applicable []model.Rule
+	for _, r := range rs {
+		if r.Global && !hasCategory(categories, r.Kategoria) {
+			continue
+		}
+		applicable = append(applicable, r)
+	}
+	return applicable
+}
+
+func hasCategory(categories []model.Category, name string) bool {
+	for _, c := range categories {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func categoryNames(categories []model.Category, typ string) []string {
+	var names []string
+	for _, c := range categories {
+		if typ == "" || c.Type == typ {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// learnRule creates a rule for the merchant of t once the user has corrected
+// it to the same category autoRuleThreshold times, or points the user's
+// existing rule for that merchant at the new category. Failures are only
+// logged, the edit itself has succeeded.
+func (s *ManagerService) learnRule(ctx context.Context, uid int64, t model.Transaction) {
+	key := learning.MerchantKey(t.Description)
+	if key == "" {
+		return
+	}
+	corrections, err := s.db.RequestCategoryCorrections(ctx, uid, correctionsWindow)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to get category corrections of user %d", uid))
+		return
+	}
+	if learning.Corrections(corrections, key, t.Kategoria) < autoRuleThreshold {
+		return
+	}
+	userRules, err := s.db.RequestUserRules(ctx, uid)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to get rules of user %d", uid))
+		return
+	}
+	rule := model.Rule{DescriptionContains: key, Type: t.Type, Kategoria: t.Kategoria}
+	for _, r := range userRules {
+		if r.Global || r.DescriptionContains != key || r.DescriptionRegex != "" || r.AmountMin != 0 || r.AmountMax != 0 {
+			continue
+		}
+		if r.Kategoria == t.Kategoria {
+			return
+		}
+		r.Kategoria = t.Kategoria
+		if err := s.db.EditRule(ctx, uid, r); err != nil {
+			logger.LogOnError(err, fmt.Sprintf("Failed to update learned rule %d", r.ID))
+		}
+		return
+	}
+	if _, err := s.db.AddRule(ctx, uid, rule); err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to add learned rule for %q", key))
+	}
+}
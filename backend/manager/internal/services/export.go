@@ -0,0 +1,51 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"manager/internal/export"
+	model "manager/internal/models"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+const exportPageSize = 500
+
+// ExportTransactions writes the transactions matching q to w page by page, so
+// the whole history is never held in memory at once. Sorting and paging
+// parameters of q are ignored: the export is always in chronological order.
+func (s *ManagerService) ExportTransactions(ctx context.Context, uid int64, format string, q model.TransactionQuery, w io.Writer) error {
+	out, err := export.NewWriter(format, w)
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownFormat) {
+			return apperror.BadRequestError(err, 4301, "unsupported export format")
+		}
+		return apperror.SystemError(err, 4302, "failed to start export")
+	}
+
+	q.SortBy = "date_asc"
+	q.Cursor = ""
+	q.Limit = exportPageSize
+	for {
+		page, err := s.db.RequestUserTransactions(ctx, uid, q)
+		if err != nil {
+			return err
+		}
+		rows := make([]export.Row, 0, len(page.Transactions))
+		for _, t := range page.Transactions {
+			rows = append(rows, export.NewRow(t, page.BaseCurrency))
+		}
+		if err := out.Write(rows); err != nil {
+			return apperror.SystemError(err, 4303, "failed to write export")
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	if err := out.Close(); err != nil {
+		return apperror.SystemError(err, 4303, "failed to write export")
+	}
+	return nil
+}
@@ -5,43 +5,110 @@ import (
 	"encoding/json"
 	"fmt"
 	model "manager/internal/models"
+	"time"
 
 	"github.com/PrototypeSirius/ruglogger/logger"
 	"github.com/sirupsen/logrus"
 )
 
+// adviceCurrency is the currency the advice prompt expects amounts in; every
+// transaction is sent with its amount converted into it.
+const adviceCurrency = "RUB"
+
+// History pages hold defaultHistoryLimit transactions unless the request asks
+// for another size, and never more than maxHistoryLimit.
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 500
+)
+
 type MLRepository interface {
-	CategorizeTransaction(ctx context.Context, uid int64, t model.TransactionMl) (string, error)
+	CategorizeTransaction(ctx context.Context, uid int64, t model.TransactionMl, categories []string, examples []model.CategoryExample) (model.CategoryPrediction, error)
+	CategorizeTransactions(ctx context.Context, uid int64, ts []model.TransactionMl, categories []string, examples []model.CategoryExample) ([]model.CategoryPrediction, error)
+	ParseTransactions(ctx context.Context, uid int64, text, tz string) ([]model.TransactionMl, error)
 	Chat(ctx context.Context, uid int64, prompt string) (string, error)
 	GetAdvice(ctx context.Context, uid int64, transactions string) (string, error)
 	ClearContext(ctx context.Context, uid int64) error
+	ChatStream(ctx context.Context, uid int64, prompt string, onToken func(string) error) error
+	AdviceStream(ctx context.Context, uid int64, transactions string, onToken func(string) error) error
 }
 
 type BotRepository interface {
 	Auth(ctx context.Context, initData string) (int64, error)
+	SendMessage(ctx context.Context, uid int64, text string) error
 }
 
 type DatabaseRepository interface {
 	AddUser(ctx context.Context, uid int64) error
-	AddTransaction(ctx context.Context, uid int64, t model.Transaction) error
+	AddTransaction(ctx context.Context, uid int64, t model.Transaction, categorize bool) (int64, error)
+	AddTransactions(ctx context.Context, uid int64, ts []model.Transaction, skipDuplicates bool) ([]int64, error)
 	DeleteTransaction(ctx context.Context, uid, tid int64) error
-	EditTransaction(ctx context.Context, uid int64, t model.Transaction) error
-	RequestUserTransactions(ctx context.Context, uid int64) ([]model.Transaction, error)
+	EditTransaction(ctx context.Context, uid int64, t model.Transaction) (bool, error)
+	RecategorizeTransaction(ctx context.Context, uid, tid int64, previous, kategoria string) (bool, error)
+	RequestUserTransactions(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, error)
+	SetBudget(ctx context.Context, uid int64, b model.Budget) (int64, error)
+	DeleteBudget(ctx context.Context, uid, bid int64) error
+	RequestUserBudgets(ctx context.Context, uid, periodStart, periodEnd int64, kategorias []string, tid int64) ([]model.Budget, error)
+	AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, error)
+	RequestUserRecurring(ctx context.Context, uid int64) ([]model.Recurring, error)
+	SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) error
+	DeleteRecurring(ctx context.Context, uid, rid int64) error
+	RequestDueRecurring(ctx context.Context, before, limit int64) ([]model.Recurring, error)
+	AdvanceRecurring(ctx context.Context, rid, runs, nextRuns, nextDate int64) (bool, error)
+	MaterializeRecurring(ctx context.Context, r model.Recurring, nextDate int64, t model.Transaction) (bool, error)
+	RequestUserStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, error)
+	AddAccount(ctx context.Context, uid int64, a model.Account) (int64, error)
+	EditAccount(ctx context.Context, uid int64, a model.Account) error
+	DeleteAccount(ctx context.Context, uid, aid int64) error
+	RequestUserAccounts(ctx context.Context, uid int64) ([]model.Account, error)
+	Transfer(ctx context.Context, uid int64, t model.Transfer) (model.TransferResult, error)
+	RequestChatMessages(ctx context.Context, uid int64, q model.ChatHistoryQuery) ([]model.ChatMessage, error)
+	AddCategory(ctx context.Context, uid int64, c model.Category) (int64, error)
+	EditCategory(ctx context.Context, uid int64, c model.Category) error
+	DeleteCategory(ctx context.Context, uid, cid int64) error
+	RequestUserCategories(ctx context.Context, uid int64) ([]model.Category, error)
+	AddRule(ctx context.Context, uid int64, r model.Rule) (int64, error)
+	EditRule(ctx context.Context, uid int64, r model.Rule) error
+	DeleteRule(ctx context.Context, uid, rid int64) error
+	RequestUserRules(ctx context.Context, uid int64) ([]model.Rule, error)
+	RequestCategoryCorrections(ctx context.Context, uid, limit int64) ([]model.CategoryCorrection, error)
+	ClaimCategorizationJobs(ctx context.Context, now, leaseUntil, limit int64) ([]model.CategorizationJob, error)
+	CompleteCategorizationJob(ctx context.Context, id, attempts int64, kategoria string) (bool, error)
+	FailCategorizationJob(ctx context.Context, id, attempts int64, errMes string, retryAt int64, final bool) error
+	StartRecategorization(ctx context.Context, uid int64, j model.Recategorization, staleBefore int64) (int64, bool, error)
+	SaveRecategorization(ctx context.Context, uid int64, j model.Recategorization, fromStatus string) (bool, error)
+	RequestRecategorization(ctx context.Context, uid int64) (model.Recategorization, bool, error)
+}
+
+type SessionIssuer interface {
+	Issue(uid int64) (model.Session, error)
+	Verify(token, kind string) (int64, error)
+}
+
+type RateLimiter interface {
+	Take(ctx context.Context, uid int64, key string) (time.Duration, error)
 }
 
 type ManagerService struct {
-	log *logrus.Logger
-	bot BotRepository
-	db  DatabaseRepository
-	ml  MLRepository
+	log            *logrus.Logger
+	bot            BotRepository
+	db             DatabaseRepository
+	ml             MLRepository
+	sessions       SessionIssuer
+	limiter        RateLimiter
+	budgetLocation *time.Location
 }
 
-func New(log *logrus.Logger, bot BotRepository, db DatabaseRepository, ml MLRepository) *ManagerService {
+func New(log *logrus.Logger, bot BotRepository, db DatabaseRepository, ml MLRepository, sessions SessionIssuer, limiter RateLimiter, budgetLocation *time.Location) *ManagerService {
 	return &ManagerService{
-		log: log,
-		bot: bot,
-		db:  db,
-		ml:  ml,
+		log:      log,
+		bot:      bot,
+		db:       db,
+		ml:       ml,
+		sessions: sessions,
+		limiter:  limiter,
+
+		budgetLocation: budgetLocation,
 	}
 }
 
@@ -58,19 +125,36 @@ func (s *ManagerService) AuthUser(ctx context.Context, initData string) (int64,
 	return uid, nil
 }
 
-func (s *ManagerService) AddTransaction(ctx context.Context, uid int64, t model.TransactionMl) error {
-	trK, err := s.ml.CategorizeTransaction(ctx, uid, t)
-	if err != nil {
-		logger.LogOnError(err, fmt.Sprintf("Failed to categorize transaction %v", t))
+// AddTransaction stores t right away and reports how its category was chosen.
+// A transaction a rule matches gets the rule's category, and for an expense
+// the state of the budget covering it is returned. A transaction no rule
+// matches is stored with a pending category and categorised by the model in
+// the background, so the request never waits for the ml service; its budget
+// is nil and is checked by the categorization job, which notifies the user in
+// Telegram when the expense crosses the limit.
+func (s *ManagerService) AddTransaction(ctx context.Context, uid int64, t model.TransactionMl) (model.Categorization, *model.Budget, error) {
+	categorization, ok := s.newCategorizer(ctx, uid).matchRule(t)
+	if !ok {
+		categorization = model.Categorization{Source: model.CategorizationPending}
 	}
 	trs := model.Transaction{
 		Date:        t.Date,
-		Kategoria:   trK,
+		Kategoria:   categorization.Kategoria,
 		Type:        t.Type,
 		Amount:      t.Amount,
 		Description: t.Description,
+		AccountID:   t.AccountID,
+		Currency:    t.Currency,
 	}
-	return s.db.AddTransaction(ctx, uid, trs)
+	id, err := s.db.AddTransaction(ctx, uid, trs, !ok)
+	if err != nil {
+		return model.Categorization{}, nil, err
+	}
+	trs.ID = id
+	if trs.Type != model.TypeExpense || trs.Kategoria == "" {
+		return categorization, nil, nil
+	}
+	return categorization, s.checkBudget(ctx, uid, trs), nil
 }
 
 func (s *ManagerService) DeleteTransaction(ctx context.Context, uid, tid int64) error {
@@ -78,28 +162,38 @@ func (s *ManagerService) DeleteTransaction(ctx context.Context, uid, tid int64)
 }
 
 func (s *ManagerService) EditTransaction(ctx context.Context, uid int64, t model.Transaction) error {
-	return s.db.EditTransaction(ctx, uid, t)
+	corrected, err := s.db.EditTransaction(ctx, uid, t)
+	if err != nil {
+		return err
+	}
+	if corrected {
+		s.learnRule(ctx, uid, t)
+	}
+	return nil
 }
 
-func (s *ManagerService) GetHistory(ctx context.Context, uid int64) ([]model.Transaction, error) {
-	return s.db.RequestUserTransactions(ctx, uid)
+func (s *ManagerService) GetHistory(ctx context.Context, uid int64, q model.TransactionQuery) (model.TransactionPage, error) {
+	if q.Limit == 0 {
+		q.Limit = defaultHistoryLimit
+	}
+	q.Limit = min(q.Limit, maxHistoryLimit)
+	return s.db.RequestUserTransactions(ctx, uid, q)
 }
 
 func (s *ManagerService) Chat(ctx context.Context, uid int64, prompt string) (string, error) {
 	return s.ml.Chat(ctx, uid, prompt)
 }
 
+func (s *ManagerService) ChatStream(ctx context.Context, uid int64, prompt string, onToken func(string) error) error {
+	return s.ml.ChatStream(ctx, uid, prompt, onToken)
+}
+
 func (s *ManagerService) GetFinancialAdvice(ctx context.Context, uid int64) (string, error) {
-	transactions, err := s.db.RequestUserTransactions(ctx, uid)
+	transactionsJSON, mes, err := s.adviceTransactions(ctx, uid)
 	if err != nil {
-		return "error getting transactions", err
+		return mes, err
 	}
-	transactionsJSON, err := json.Marshal(transactions)
-	if err != nil {
-		logger.LogOnError(err, "Failed to marshal transactions for advice")
-		return "Error marshaling transactions", err
-	}
-	advice, err := s.ml.GetAdvice(ctx, uid, string(transactionsJSON))
+	advice, err := s.ml.GetAdvice(ctx, uid, transactionsJSON)
 	if err != nil {
 		logger.LogOnError(err, "Failed to get financial advice from ML service")
 		return "Failed to get financial advice", err
@@ -108,6 +202,31 @@ func (s *ManagerService) GetFinancialAdvice(ctx context.Context, uid int64) (str
 	return advice, nil
 }
 
+func (s *ManagerService) GetFinancialAdviceStream(ctx context.Context, uid int64, onToken func(string) error) error {
+	transactionsJSON, _, err := s.adviceTransactions(ctx, uid)
+	if err != nil {
+		return err
+	}
+	return s.ml.AdviceStream(ctx, uid, transactionsJSON, onToken)
+}
+
+func (s *ManagerService) adviceTransactions(ctx context.Context, uid int64) (string, string, error) {
+	page, err := s.db.RequestUserTransactions(ctx, uid, model.TransactionQuery{BaseCurrency: adviceCurrency})
+	if err != nil {
+		return "", "error getting transactions", err
+	}
+	transactionsJSON, err := json.Marshal(page.Transactions)
+	if err != nil {
+		logger.LogOnError(err, "Failed to marshal transactions for advice")
+		return "", "Error marshaling transactions", err
+	}
+	return string(transactionsJSON), "", nil
+}
+
 func (s *ManagerService) ClearChatContext(ctx context.Context, uid int64) error {
 	return s.ml.ClearContext(ctx, uid)
 }
+
+func (s *ManagerService) GetChatHistory(ctx context.Context, uid int64, q model.ChatHistoryQuery) ([]model.ChatMessage, error) {
+	return s.db.RequestChatMessages(ctx, uid, q)
+}
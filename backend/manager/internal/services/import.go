@@ -0,0 +1,84 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	model "manager/internal/models"
+	"manager/internal/statement"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	ImportStatusImported  = "imported"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusRejected  = "rejected"
+)
+
+// ImportStatement imports the statement into the given account, or into the
+// default account when accountID is 0.
+func (s *ManagerService) ImportStatement(ctx context.Context, uid int64, format string, accountID int64, data []byte) (model.ImportReport, error) {
+	format, rows, err := statement.Parse(format, data)
+	if err != nil {
+		if errors.Is(err, statement.ErrUnknownFormat) {
+			return model.ImportReport{}, apperror.BadRequestError(err, 4201, "unsupported statement format")
+		}
+		return model.ImportReport{}, apperror.BadRequestError(err, 4202, "failed to parse statement")
+	}
+
+	var parsed []model.TransactionMl
+	for _, row := range rows {
+		if row.Err == nil {
+			parsed = append(parsed, row.Transaction)
+		}
+	}
+	categorizations, err := s.categorizeAll(ctx, s.newCategorizer(ctx, uid), parsed)
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to categorize statement of user %d", uid))
+	}
+
+	report := model.ImportReport{Format: format, Rows: make([]model.ImportRow, 0, len(rows))}
+	var transactions []model.Transaction
+	var pending []int
+	for _, row := range rows {
+		if row.Err != nil {
+			report.Rows = append(report.Rows, model.ImportRow{Line: row.Line, Status: ImportStatusRejected, Reason: row.Err.Error()})
+			report.Rejected++
+			continue
+		}
+		categorization := categorizations[len(transactions)]
+		t := model.Transaction{
+			Date:        row.Transaction.Date,
+			Kategoria:   categorization.Kategoria,
+			Type:        row.Transaction.Type,
+			Amount:      row.Transaction.Amount,
+			Description: row.Transaction.Description,
+			AccountID:   accountID,
+		}
+		transactions = append(transactions, t)
+		pending = append(pending, len(report.Rows))
+		report.Rows = append(report.Rows, model.ImportRow{Line: row.Line, Transaction: &t, Categorization: &categorization})
+	}
+	if len(transactions) == 0 {
+		return report, nil
+	}
+
+	ids, err := s.db.AddTransactions(ctx, uid, transactions, true)
+	if err != nil {
+		return model.ImportReport{}, err
+	}
+	for i, id := range ids {
+		row := &report.Rows[pending[i]]
+		if id == 0 {
+			row.Status = ImportStatusDuplicate
+			report.Duplicates++
+			continue
+		}
+		row.Status = ImportStatusImported
+		row.Transaction.ID = id
+		report.Imported++
+	}
+	return report, nil
+}
@@ -0,0 +1,77 @@
+package httpservice
+
+import (
+	"context"
+	"fmt"
+	model "manager/internal/models"
+	"strings"
+	"time"
+)
+
+const (
+	// jobLeaseMargin keeps a job leased a bit past its timeout, so it is not
+	// claimed again while the worker is still recording the result.
+	jobLeaseMargin    = time.Minute
+	jobBackoff        = 30 * time.Second
+	maxJobBackoff     = 30 * time.Minute
+	maxJobErrorLength = 1000
+)
+
+// RunCategorizationJob claims one queued transaction and categorises it
+// within timeout. A failed attempt is retried with exponential backoff until
+// maxAttempts is reached; the transaction then keeps an empty category. It
+// reports whether a job was found, so idle workers can wait.
+func (s *ManagerService) RunCategorizationJob(ctx context.Context, now time.Time, timeout time.Duration, maxAttempts int64) (bool, error) {
+	jobs, err := s.db.ClaimCategorizationJobs(ctx, now.UnixMilli(), now.Add(timeout+jobLeaseMargin).UnixMilli(), 1)
+	if err != nil {
+		return false, err
+	}
+	if len(jobs) == 0 {
+		return false, nil
+	}
+	job := jobs[0]
+	t := job.Transaction
+
+	jobCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	categorization, err := s.tryCategorize(jobCtx, s.newCategorizer(jobCtx, job.UserID), model.TransactionMl{
+		Date:        t.Date,
+		Type:        t.Type,
+		Amount:      t.Amount,
+		Description: t.Description,
+	})
+	if err != nil {
+		final := job.Attempts >= maxAttempts
+		retryAt := time.Now().Add(jobRetryDelay(job.Attempts)).UnixMilli()
+		if failErr := s.db.FailCategorizationJob(ctx, job.ID, job.Attempts, truncate(err.Error(), maxJobErrorLength), retryAt, final); failErr != nil {
+			return true, failErr
+		}
+		return true, fmt.Errorf("categorization job %d, attempt %d: %w", job.ID, job.Attempts, err)
+	}
+
+	applied, err := s.db.CompleteCategorizationJob(ctx, job.ID, job.Attempts, categorization.Kategoria)
+	if err != nil {
+		return true, err
+	}
+	if applied && t.Type == model.TypeExpense && categorization.Kategoria != "" {
+		t.Kategoria = categorization.Kategoria
+		s.checkBudget(ctx, job.UserID, t)
+	}
+	return true, nil
+}
+
+// jobRetryDelay doubles with every failed attempt up to maxJobBackoff.
+func jobRetryDelay(attempts int64) time.Duration {
+	delay := jobBackoff
+	for i := int64(1); i < attempts && delay < maxJobBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxJobBackoff)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,36 @@
+package httpservice
+
+import (
+	"context"
+	model "manager/internal/models"
+)
+
+// ParseTransactions reads the transactions written in req.Text for the user
+// to confirm. Relative dates are resolved in the budget timezone unless
+// req.Timezone names another. With req.Insert they are added one by one like
+// transactions entered in the form; when one fails, the error comes with the
+// transactions added before it, which stay added.
+func (s *ManagerService) ParseTransactions(ctx context.Context, uid int64, req model.ParseRequest) ([]model.ParsedTransaction, error) {
+	if req.Timezone == "" {
+		req.Timezone = s.budgetLocation.String()
+	}
+	ts, err := s.ml.ParseTransactions(ctx, uid, req.Text, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	parsed := make([]model.ParsedTransaction, len(ts))
+	for i, t := range ts {
+		t.AccountID = req.AccountID
+		parsed[i].Transaction = t
+		if !req.Insert {
+			continue
+		}
+		categorization, budget, err := s.AddTransaction(ctx, uid, t)
+		if err != nil {
+			return parsed[:i], err
+		}
+		parsed[i].Categorization = &categorization
+		parsed[i].Budget = budget
+	}
+	return parsed, nil
+}
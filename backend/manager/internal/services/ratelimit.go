@@ -0,0 +1,12 @@
+package httpservice
+
+import (
+	"context"
+	"time"
+)
+
+// TakeRateLimit counts a request of the rate limited endpoint key and returns
+// how long the user has to wait when it is over the limit.
+func (s *ManagerService) TakeRateLimit(ctx context.Context, uid int64, key string) (time.Duration, error) {
+	return s.limiter.Take(ctx, uid, key)
+}
@@ -0,0 +1,220 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	model "manager/internal/models"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const (
+	recategorizePage    = 500
+	recategorizeBatch   = 100
+	recategorizeTimeout = time.Hour
+)
+
+// StartRecategorization labels the selected transactions of the user again in
+// the background. Without a dry run every change is written as soon as it is
+// found. A user runs one re-categorisation at a time. The job is stored
+// through the database service, so any replica can report and apply it.
+func (s *ManagerService) StartRecategorization(ctx context.Context, uid int64, req model.RecategorizationRequest) (int64, error) {
+	if req.DateTo != 0 && req.DateTo < req.DateFrom {
+		return 0, apperror.BadRequestError(errors.New("date_to before date_from"), 4401, "date_to must not be before date_from")
+	}
+	j := &model.Recategorization{
+		Status:    model.RecategorizationRunning,
+		Request:   req,
+		StartedAt: time.Now().UnixMilli(),
+		Changes:   []model.CategoryChange{},
+	}
+	// A job that has not been saved for recategorizeTimeout was left by a
+	// replica that stopped.
+	staleBefore := time.Now().Add(-recategorizeTimeout).UnixMilli()
+	id, started, err := s.db.StartRecategorization(ctx, uid, *j, staleBefore)
+	if err != nil {
+		return 0, err
+	}
+	if !started {
+		return 0, apperror.BadRequestError(errors.New("recategorization in progress"), 4402, "Recategorization is already in progress")
+	}
+	j.ID = id
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recategorizeTimeout)
+		defer cancel()
+		s.finishRecategorization(ctx, uid, j, s.recategorize(ctx, uid, j))
+	}()
+	return id, nil
+}
+
+func (s *ManagerService) GetRecategorization(ctx context.Context, uid int64) (model.Recategorization, error) {
+	j, ok, err := s.db.RequestRecategorization(ctx, uid)
+	if err != nil {
+		return model.Recategorization{}, err
+	}
+	if !ok {
+		return model.Recategorization{}, apperror.NotFoundError(errors.New("no recategorization"), 4403, "Recategorization not found")
+	}
+	return j, nil
+}
+
+// ApplyRecategorization writes the changes of a dry run that is in the
+// preview status. id guards against applying a preview the user has not seen.
+func (s *ManagerService) ApplyRecategorization(ctx context.Context, uid, id int64) error {
+	j, ok, err := s.db.RequestRecategorization(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if !ok || j.ID != id {
+		return apperror.NotFoundError(errors.New("no recategorization"), 4403, "Recategorization not found")
+	}
+	if j.Status != model.RecategorizationPreview {
+		return apperror.BadRequestError(fmt.Errorf("recategorization is %s", j.Status), 4404, "Only a finished dry run can be applied")
+	}
+	j.Status = model.RecategorizationApplying
+	// Only the request that moves the job out of the preview applies it.
+	claimed, err := s.db.SaveRecategorization(ctx, uid, j, model.RecategorizationPreview)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return apperror.BadRequestError(errors.New("recategorization is not in preview"), 4404, "Only a finished dry run can be applied")
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recategorizeTimeout)
+		defer cancel()
+		var err error
+		for i := range j.Changes {
+			if err = s.applyChange(ctx, uid, &j, i); err != nil {
+				break
+			}
+			if (i+1)%recategorizeBatch == 0 {
+				s.saveRecategorization(ctx, uid, &j)
+			}
+		}
+		s.finishRecategorization(ctx, uid, &j, err)
+	}()
+	return nil
+}
+
+func (s *ManagerService) recategorize(ctx context.Context, uid int64, j *model.Recategorization) error {
+	req := j.Request
+	transactions, err := s.selectForRecategorization(ctx, uid, req)
+	if err != nil {
+		return err
+	}
+	j.Total = len(transactions)
+	s.saveRecategorization(ctx, uid, j)
+
+	c := s.newCategorizer(ctx, uid)
+	for start := 0; start < len(transactions); start += recategorizeBatch {
+		batch := transactions[start:min(start+recategorizeBatch, len(transactions))]
+		ts := make([]model.TransactionMl, len(batch))
+		for i, t := range batch {
+			ts[i] = model.TransactionMl{Date: t.Date, Type: t.Type, Amount: t.Amount, Description: t.Description}
+		}
+		categorizations, err := s.categorizeAll(ctx, c, ts)
+		if err != nil {
+			return err
+		}
+		for i, t := range batch {
+			categorization := categorizations[i]
+			if categorization.Kategoria == "" || categorization.Kategoria == t.Kategoria {
+				continue
+			}
+			change := model.CategoryChange{
+				TransactionID: t.ID,
+				Date:          t.Date,
+				Type:          t.Type,
+				Amount:        t.Amount,
+				Description:   t.Description,
+				OldKategoria:  t.Kategoria,
+				New:           categorization,
+			}
+			j.Changes = append(j.Changes, change)
+			j.Changed++
+			if !req.DryRun {
+				if err := s.applyChange(ctx, uid, j, len(j.Changes)-1); err != nil {
+					return err
+				}
+			}
+		}
+		j.Processed += len(batch)
+		s.saveRecategorization(ctx, uid, j)
+	}
+	return nil
+}
+
+// selectForRecategorization pages through the transactions matching the
+// request. Transfers keep their category and are left out.
+func (s *ManagerService) selectForRecategorization(ctx context.Context, uid int64, req model.RecategorizationRequest) ([]model.Transaction, error) {
+	q := model.TransactionQuery{
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+		Kategorias: req.Kategorias,
+		Limit:      recategorizePage,
+	}
+	var transactions []model.Transaction
+	for {
+		page, err := s.db.RequestUserTransactions(ctx, uid, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range page.Transactions {
+			if t.TransferID == 0 && !t.CategoryPending {
+				transactions = append(transactions, t)
+			}
+		}
+		if page.NextCursor == "" {
+			return transactions, nil
+		}
+		q.Cursor = page.NextCursor
+	}
+}
+
+func (s *ManagerService) applyChange(ctx context.Context, uid int64, j *model.Recategorization, index int) error {
+	change := j.Changes[index]
+	applied, err := s.db.RecategorizeTransaction(ctx, uid, change.TransactionID, change.OldKategoria, change.New.Kategoria)
+	if err != nil {
+		return err
+	}
+	if applied {
+		j.Changes[index].Applied = true
+		j.Applied++
+	}
+	return nil
+}
+
+// saveRecategorization stores the progress of j. A failed save only delays
+// the progress the user sees, so it is logged and the job goes on.
+func (s *ManagerService) saveRecategorization(ctx context.Context, uid int64, j *model.Recategorization) {
+	if _, err := s.db.SaveRecategorization(ctx, uid, *j, ""); err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to save recategorization %d of user %d", j.ID, uid))
+	}
+}
+
+func (s *ManagerService) finishRecategorization(ctx context.Context, uid int64, j *model.Recategorization, err error) {
+	if err != nil {
+		logger.LogOnError(err, fmt.Sprintf("Failed to recategorize transactions of user %d", uid))
+	}
+	j.FinishedAt = time.Now().UnixMilli()
+	switch {
+	case err != nil:
+		j.Status = model.RecategorizationFailed
+		j.Error = err.Error()
+	case j.Status == model.RecategorizationRunning && j.Request.DryRun:
+		j.Status = model.RecategorizationPreview
+	default:
+		j.Status = model.RecategorizationDone
+	}
+	// The job context may be the one that ended, and the final state must
+	// still be stored.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	s.saveRecategorization(ctx, uid, j)
+}
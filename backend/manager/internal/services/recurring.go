@@ -0,0 +1,151 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	model "manager/internal/models"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"github.com/PrototypeSirius/ruglogger/logger"
+)
+
+const dueRecurringBatch = 100
+
+func (s *ManagerService) GetRecurring(ctx context.Context, uid int64) ([]model.Recurring, error) {
+	return s.db.RequestUserRecurring(ctx, uid)
+}
+
+func (s *ManagerService) AddRecurring(ctx context.Context, uid int64, r model.Recurring) (int64, error) {
+	if r.EndDate != 0 && r.EndDate < r.StartDate {
+		return 0, apperror.BadRequestError(errors.New("end date before start date"), 4101, "end date must not be before start date")
+	}
+	if r.Kategoria == "" {
+		r.Kategoria = s.categorize(ctx, s.newCategorizer(ctx, uid), model.TransactionMl{
+			Date:        r.StartDate,
+			Type:        r.Type,
+			Amount:      r.Amount,
+			Description: r.Description,
+		}).Kategoria
+	}
+	return s.db.AddRecurring(ctx, uid, r)
+}
+
+// SetRecurringPaused pauses or resumes a template. On resume the template is
+// first moved past the occurrences missed while it was paused, so they are
+// not materialised retroactively.
+func (s *ManagerService) SetRecurringPaused(ctx context.Context, uid, rid int64, paused bool) error {
+	if !paused {
+		if err := s.skipMissedOccurrences(ctx, uid, rid, time.Now()); err != nil {
+			return err
+		}
+	}
+	return s.db.SetRecurringPaused(ctx, uid, rid, paused)
+}
+
+func (s *ManagerService) DeleteRecurring(ctx context.Context, uid, rid int64) error {
+	return s.db.DeleteRecurring(ctx, uid, rid)
+}
+
+// MaterializeDueRecurring inserts every occurrence that is due by now. Each
+// occurrence is claimed and inserted in one database transaction, so
+// concurrent schedulers never insert the same occurrence twice and a failed
+// insert is retried on the next run. A template that failed stays due, so the
+// run stops once a batch holds only templates that already failed in it.
+func (s *ManagerService) MaterializeDueRecurring(ctx context.Context, now time.Time) error {
+	failed := make(map[int64]bool)
+	for {
+		due, err := s.db.RequestDueRecurring(ctx, now.UnixMilli(), dueRecurringBatch)
+		if err != nil {
+			return err
+		}
+		progress := false
+		for _, r := range due {
+			if failed[r.ID] {
+				continue
+			}
+			progress = true
+			if err := s.materialize(ctx, r, now); err != nil {
+				failed[r.ID] = true
+				logger.LogOnError(err, fmt.Sprintf("Failed to materialize recurring transaction %d", r.ID))
+			}
+		}
+		if len(due) < dueRecurringBatch || !progress {
+			return nil
+		}
+	}
+}
+
+func (s *ManagerService) materialize(ctx context.Context, r model.Recurring, now time.Time) error {
+	for r.NextDate <= now.UnixMilli() && (r.EndDate == 0 || r.NextDate <= r.EndDate) {
+		date := r.NextDate
+		next := occurrence(r.StartDate, r.Frequency, r.Runs+1, s.budgetLocation)
+		if next <= date {
+			return apperror.SystemError(fmt.Errorf("frequency %q does not advance", r.Frequency), 4103, "invalid recurring transaction")
+		}
+		claimed, err := s.db.MaterializeRecurring(ctx, r, next, model.Transaction{
+			Date:        date,
+			Kategoria:   r.Kategoria,
+			Type:        r.Type,
+			Amount:      r.Amount,
+			Description: r.Description,
+		})
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return nil
+		}
+		r.Runs++
+		r.NextDate = next
+	}
+	return nil
+}
+
+func (s *ManagerService) skipMissedOccurrences(ctx context.Context, uid, rid int64, now time.Time) error {
+	recurring, err := s.db.RequestUserRecurring(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, r := range recurring {
+		if r.ID != rid {
+			continue
+		}
+		if !r.Paused || r.NextDate >= now.UnixMilli() {
+			return nil
+		}
+		runs := r.Runs
+		for occurrence(r.StartDate, r.Frequency, runs, s.budgetLocation) < now.UnixMilli() {
+			runs++
+		}
+		_, err := s.db.AdvanceRecurring(ctx, r.ID, r.Runs, runs, occurrence(r.StartDate, r.Frequency, runs, s.budgetLocation))
+		return err
+	}
+	return apperror.NotFoundError(errors.New("recurring transaction not found"), 4102, "Recurring transaction not found")
+}
+
+// occurrence returns the date of the n-th occurrence counted from start.
+// Monthly and yearly schedules keep the day of month of start and fall back to
+// the last day of shorter months instead of spilling into the next one. Days
+// and months are counted in loc, the zone budget months start in.
+func occurrence(start int64, frequency string, n int64, loc *time.Location) int64 {
+	t := time.UnixMilli(start).In(loc)
+	switch frequency {
+	case "daily":
+		return t.AddDate(0, 0, int(n)).UnixMilli()
+	case "weekly":
+		return t.AddDate(0, 0, 7*int(n)).UnixMilli()
+	case "monthly":
+		return addMonths(t, int(n)).UnixMilli()
+	case "yearly":
+		return addMonths(t, 12*int(n)).UnixMilli()
+	}
+	return t.UnixMilli()
+}
+
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := min(t.Day(), first.AddDate(0, 1, -1).Day())
+	return time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,55 @@
+package httpservice
+
+import (
+	"context"
+	"errors"
+	model "manager/internal/models"
+	"manager/internal/session"
+	"net/http"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+// CreateSession validates initData once and issues tokens that let later
+// requests skip the bot and database round trips of AuthUser.
+func (s *ManagerService) CreateSession(ctx context.Context, initData string) (model.Session, error) {
+	uid, err := s.AuthUser(ctx, initData)
+	if err != nil {
+		return model.Session{}, err
+	}
+	return s.issueSession(uid)
+}
+
+// RefreshSession trades a refresh token for a new pair of tokens.
+func (s *ManagerService) RefreshSession(ctx context.Context, refreshToken string) (model.Session, error) {
+	uid, err := s.verifyToken(refreshToken, session.KindRefresh)
+	if err != nil {
+		return model.Session{}, err
+	}
+	return s.issueSession(uid)
+}
+
+// VerifySession returns the user of an access token without leaving the
+// process.
+func (s *ManagerService) VerifySession(token string) (int64, error) {
+	return s.verifyToken(token, session.KindAccess)
+}
+
+func (s *ManagerService) issueSession(uid int64) (model.Session, error) {
+	sess, err := s.sessions.Issue(uid)
+	if err != nil {
+		return model.Session{}, apperror.SystemError(err, 4603, "failed to issue session")
+	}
+	return sess, nil
+}
+
+func (s *ManagerService) verifyToken(token, kind string) (int64, error) {
+	uid, err := s.sessions.Verify(token, kind)
+	if errors.Is(err, session.ErrExpired) {
+		return 0, apperror.CustomError(err, http.StatusUnauthorized, 4601, "Session expired")
+	}
+	if err != nil {
+		return 0, apperror.CustomError(err, http.StatusUnauthorized, 4602, "Invalid session token")
+	}
+	return uid, nil
+}
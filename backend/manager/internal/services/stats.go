@@ -0,0 +1,19 @@
+package httpservice
+
+import (
+	"context"
+	model "manager/internal/models"
+)
+
+const defaultStatsGranularity = "month"
+
+func (s *ManagerService) GetStats(ctx context.Context, uid int64, q model.StatsQuery) (model.Stats, error) {
+	if q.Granularity == "" {
+		q.Granularity = defaultStatsGranularity
+	}
+	// Periods start where budget months do unless the client asks otherwise.
+	if q.Timezone == "" {
+		q.Timezone = s.budgetLocation.String()
+	}
+	return s.db.RequestUserStats(ctx, uid, q)
+}
@@ -0,0 +1,188 @@
+package httpservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"manager/internal/export"
+	model "manager/internal/models"
+	"time"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+)
+
+const (
+	toolDateLayout       = "2006-01-02"
+	toolCurrency         = "RUB"
+	defaultToolListLimit = 20
+	maxToolListLimit     = 50
+)
+
+var dateRangeParameters = map[string]any{
+	"date_from": map[string]any{"type": "string", "description": "First day of the range, YYYY-MM-DD"},
+	"date_to":   map[string]any{"type": "string", "description": "Last day of the range, YYYY-MM-DD, included"},
+}
+
+// chatTools are the functions the chat model may call to read the data of
+// the user it talks to.
+var chatTools = []model.Tool{
+	{
+		Name:        "get_spending_by_category",
+		Description: "Total income and expenses of the user per category over a date range, in RUB.",
+		Parameters: map[string]any{
+			"type":       "object",
+			"properties": dateRangeParameters,
+			"required":   []string{"date_from", "date_to"},
+		},
+	},
+	{
+		Name:        "list_transactions",
+		Description: "Transactions of the user over a date range, newest first, with amounts in RUB that are negative for expenses.",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date_from": dateRangeParameters["date_from"],
+				"date_to":   dateRangeParameters["date_to"],
+				"kategoria": map[string]any{"type": "string", "description": "Only transactions of this category"},
+				"type":      map[string]any{"type": "string", "enum": []string{"income", "expense"}},
+				"limit":     map[string]any{"type": "integer", "description": fmt.Sprintf("At most %d", maxToolListLimit)},
+			},
+			"required": []string{"date_from", "date_to"},
+		},
+	},
+	{
+		Name:        "get_budget_status",
+		Description: "Monthly budgets of the user for the current month with the amount spent and remaining.",
+		Parameters:  map[string]any{"type": "object", "properties": map[string]any{}},
+	},
+}
+
+type toolDateRange struct {
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+}
+
+// bounds turns the range of days in loc into epoch milliseconds, date_to
+// included.
+func (r toolDateRange) bounds(loc *time.Location) (int64, int64, error) {
+	from, err := time.ParseInLocation(toolDateLayout, r.DateFrom, loc)
+	if err != nil {
+		return 0, 0, apperror.BadRequestError(err, 4502, "date_from must be YYYY-MM-DD")
+	}
+	to, err := time.ParseInLocation(toolDateLayout, r.DateTo, loc)
+	if err != nil {
+		return 0, 0, apperror.BadRequestError(err, 4503, "date_to must be YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return 0, 0, apperror.BadRequestError(errors.New("date_to before date_from"), 4504, "date_to must not be before date_from")
+	}
+	return from.UnixMilli(), to.AddDate(0, 0, 1).UnixMilli() - 1, nil
+}
+
+func (s *ManagerService) GetTools() []model.Tool {
+	return chatTools
+}
+
+// CallTool runs the chat tool name with the JSON arguments for the user and
+// returns its result.
+func (s *ManagerService) CallTool(ctx context.Context, uid int64, name string, args json.RawMessage) (any, error) {
+	switch name {
+	case "get_spending_by_category":
+		var a toolDateRange
+		if err := decodeToolArguments(args, &a); err != nil {
+			return nil, err
+		}
+		return s.spendingByCategory(ctx, uid, a)
+	case "list_transactions":
+		var a struct {
+			toolDateRange
+			Kategoria string `json:"kategoria"`
+			Type      string `json:"type"`
+			Limit     int64  `json:"limit"`
+		}
+		if err := decodeToolArguments(args, &a); err != nil {
+			return nil, err
+		}
+		return s.listTransactions(ctx, uid, a.toolDateRange, a.Kategoria, a.Type, a.Limit)
+	case "get_budget_status":
+		budgets, err := s.GetBudgets(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"month": time.Now().In(s.budgetLocation).Format("2006-01"), "budgets": budgets}, nil
+	}
+	return nil, apperror.NotFoundError(fmt.Errorf("unknown tool %q", name), 4501, "Tool not found")
+}
+
+func (s *ManagerService) spendingByCategory(ctx context.Context, uid int64, r toolDateRange) (any, error) {
+	from, to, err := r.bounds(s.budgetLocation)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.GetStats(ctx, uid, model.StatsQuery{DateFrom: from, DateTo: to, BaseCurrency: toolCurrency})
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]map[string]any, len(stats.Categories))
+	for i, c := range stats.Categories {
+		categories[i] = map[string]any{"kategoria": c.Kategoria, "type": toolType(c.Type), "amount": c.Amount, "count": c.Count}
+	}
+	return map[string]any{
+		"date_from":  r.DateFrom,
+		"date_to":    r.DateTo,
+		"currency":   stats.BaseCurrency,
+		"income":     stats.Income,
+		"expense":    stats.Expense,
+		"categories": categories,
+	}, nil
+}
+
+func (s *ManagerService) listTransactions(ctx context.Context, uid int64, r toolDateRange, kategoria, typ string, limit int64) (any, error) {
+	from, to, err := r.bounds(s.budgetLocation)
+	if err != nil {
+		return nil, err
+	}
+	q := model.TransactionQuery{DateFrom: from, DateTo: to, BaseCurrency: toolCurrency, Limit: defaultToolListLimit}
+	if limit > 0 {
+		q.Limit = min(limit, maxToolListLimit)
+	}
+	if kategoria != "" {
+		q.Kategorias = []string{kategoria}
+	}
+	switch typ {
+	case "":
+	case "income":
+		q.Type = model.TypeIncome
+	case "expense":
+		q.Type = model.TypeExpense
+	default:
+		return nil, apperror.BadRequestError(fmt.Errorf("unknown type %q", typ), 4505, "type must be income or expense")
+	}
+	page, err := s.GetHistory(ctx, uid, q)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]export.Row, len(page.Transactions))
+	for i, t := range page.Transactions {
+		rows[i] = export.NewRow(t, page.BaseCurrency)
+	}
+	return map[string]any{"total": page.Total, "transactions": rows}, nil
+}
+
+func decodeToolArguments(args json.RawMessage, v any) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(args, v); err != nil {
+		return apperror.BadRequestError(err, 4506, "invalid tool arguments")
+	}
+	return nil
+}
+
+func toolType(t string) string {
+	if t == model.TypeExpense {
+		return "expense"
+	}
+	return "income"
+}
@@ -0,0 +1,102 @@
+package statement
+
+import (
+	"bufio"
+	"errors"
+	model "manager/internal/models"
+	"strings"
+)
+
+// parseClientBank reads the 1C "1CClientBankExchange" text format. A document
+// is an expense when the statement account is the payer and income when it is
+// the recipient; the ДатаСписано/ДатаПоступило pair is used when the account
+// section is missing.
+func parseClientBank(text string) ([]Row, error) {
+	sc := bufio.NewScanner(strings.NewReader(text))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	accounts := make(map[string]bool)
+	var rows []Row
+	var doc map[string]string
+	docLine := 0
+	line := 0
+	for sc.Scan() {
+		line++
+		s := strings.TrimSpace(sc.Text())
+		if line == 1 {
+			if s != "1CClientBankExchange" {
+				return nil, errors.New("missing 1CClientBankExchange header")
+			}
+			continue
+		}
+		key, value, _ := strings.Cut(s, "=")
+		switch {
+		case key == "СекцияДокумент":
+			doc = map[string]string{}
+			docLine = line
+		case key == "КонецДокумента":
+			if doc != nil {
+				t, err := clientBankTransaction(doc, accounts)
+				rows = append(rows, Row{Line: docLine, Transaction: t, Err: err})
+			}
+			doc = nil
+		case doc != nil:
+			doc[key] = strings.TrimSpace(value)
+		case key == "РасчСчет":
+			accounts[strings.TrimSpace(value)] = true
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func clientBankTransaction(doc map[string]string, accounts map[string]bool) (model.TransactionMl, error) {
+	amount, _, err := parseAmount(doc["Сумма"])
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+
+	var negative bool
+	switch {
+	case accounts[doc["ПлательщикСчет"]]:
+		negative = true
+	case accounts[doc["ПолучательСчет"]]:
+		negative = false
+	case doc["ДатаСписано"] != "":
+		negative = true
+	case doc["ДатаПоступило"] != "":
+		negative = false
+	default:
+		return model.TransactionMl{}, errors.New("cannot determine payment direction")
+	}
+
+	dateField := doc["ДатаПоступило"]
+	counterparty := firstNonEmpty(doc["Плательщик"], doc["Плательщик1"])
+	if negative {
+		dateField = doc["ДатаСписано"]
+		counterparty = firstNonEmpty(doc["Получатель"], doc["Получатель1"])
+	}
+	date, err := parseDate(firstNonEmpty(dateField, doc["Дата"]))
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+
+	description := strings.TrimSpace(counterparty + " " + doc["НазначениеПлатежа"])
+	return model.TransactionMl{
+		Date:        date,
+		Type:        transactionType(negative),
+		Amount:      amount,
+		Description: description,
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
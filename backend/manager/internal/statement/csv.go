@@ -0,0 +1,161 @@
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	model "manager/internal/models"
+	"strings"
+)
+
+var csvColumns = map[string][]string{
+	"date":         {"date", "дата", "дата операции", "дата платежа", "дата проводки", "transaction date"},
+	"amount":       {"amount", "сумма", "сумма операции", "сумма платежа"},
+	"income":       {"income", "credit", "приход", "поступление", "зачисление"},
+	"expense":      {"expense", "debit", "расход", "списание"},
+	"type":         {"type", "тип", "тип операции"},
+	"description":  {"description", "описание", "описание операции", "назначение платежа", "назначение", "комментарий"},
+	"counterparty": {"counterparty", "merchant", "контрагент", "получатель"},
+}
+
+func parseCSV(text string) ([]Row, error) {
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comma = detectDelimiter(text)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	cols := mapColumns(header)
+	if _, ok := cols["date"]; !ok {
+		return nil, errors.New("csv has no date column")
+	}
+	_, hasAmount := cols["amount"]
+	_, hasIncome := cols["income"]
+	_, hasExpense := cols["expense"]
+	if !hasAmount && !hasIncome && !hasExpense {
+		return nil, errors.New("csv has no amount column")
+	}
+
+	var rows []Row
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rows = append(rows, Row{Line: parseErr.StartLine, Err: err})
+				continue
+			}
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		if isBlank(record) {
+			continue
+		}
+		t, err := csvTransaction(cols, record)
+		rows = append(rows, Row{Line: line, Transaction: t, Err: err})
+	}
+	return rows, nil
+}
+
+func csvTransaction(cols map[string]int, record []string) (model.TransactionMl, error) {
+	field := func(name string) string {
+		i, ok := cols[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date, err := parseDate(field("date"))
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+
+	var amount int64
+	var negative bool
+	switch {
+	case field("amount") != "":
+		amount, negative, err = parseAmount(field("amount"))
+	case field("expense") != "":
+		amount, _, err = parseAmount(field("expense"))
+		negative = true
+	case field("income") != "":
+		amount, _, err = parseAmount(field("income"))
+	default:
+		err = errors.New("empty amount")
+	}
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+
+	typ := transactionType(negative)
+	if declared := strings.ToLower(field("type")); declared != "" {
+		typ = transactionType(!isIncomeType(declared))
+	}
+
+	description := field("description")
+	if counterparty := field("counterparty"); counterparty != "" && !strings.Contains(description, counterparty) {
+		description = strings.TrimSpace(counterparty + " " + description)
+	}
+
+	return model.TransactionMl{
+		Date:        date,
+		Type:        typ,
+		Amount:      amount,
+		Description: description,
+	}, nil
+}
+
+func isIncomeType(declared string) bool {
+	for _, marker := range []string{"доход", "пополн", "поступ", "зачисл", "income", "credit"} {
+		if strings.Contains(declared, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func mapColumns(header []string) map[string]int {
+	cols := make(map[string]int)
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		for name, aliases := range csvColumns {
+			if _, taken := cols[name]; taken {
+				continue
+			}
+			for _, alias := range aliases {
+				if h == alias {
+					cols[name] = i
+				}
+			}
+		}
+	}
+	return cols
+}
+
+func detectDelimiter(text string) rune {
+	firstLine, _, _ := strings.Cut(text, "\n")
+	best, bestCount := ',', 0
+	for _, d := range []rune{';', ',', '\t', '|'} {
+		if n := strings.Count(firstLine, string(d)); n > bestCount {
+			best, bestCount = d, n
+		}
+	}
+	return best
+}
+
+func isBlank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	model "manager/internal/models"
+	"strings"
+	"time"
+)
+
+// parseOFX handles both OFX 1.x (SGML, unclosed leaf tags) and OFX 2.x (XML)
+// by scanning every <STMTTRN> aggregate for the leaf values it needs.
+func parseOFX(text string) ([]Row, error) {
+	upper := strings.ToUpper(text)
+	if !strings.Contains(upper, "<OFX>") {
+		return nil, errors.New("ofx has no <OFX> root")
+	}
+	var rows []Row
+	offset := 0
+	for n := 1; ; n++ {
+		start := strings.Index(upper[offset:], "<STMTTRN>")
+		if start == -1 {
+			break
+		}
+		start += offset + len("<STMTTRN>")
+		end := strings.Index(upper[start:], "</STMTTRN>")
+		if end == -1 {
+			rows = append(rows, Row{Line: n, Err: errors.New("unterminated STMTTRN")})
+			break
+		}
+		block := text[start : start+end]
+		offset = start + end + len("</STMTTRN>")
+		t, err := ofxTransaction(block)
+		rows = append(rows, Row{Line: n, Transaction: t, Err: err})
+	}
+	return rows, nil
+}
+
+func ofxTransaction(block string) (model.TransactionMl, error) {
+	date, err := parseOFXDate(ofxValue(block, "DTPOSTED"))
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+	amount, negative, err := parseAmount(ofxValue(block, "TRNAMT"))
+	if err != nil {
+		return model.TransactionMl{}, err
+	}
+	description := ofxValue(block, "NAME")
+	if memo := ofxValue(block, "MEMO"); memo != "" && memo != description {
+		description = strings.TrimSpace(description + " " + memo)
+	}
+	return model.TransactionMl{
+		Date:        date,
+		Type:        transactionType(negative),
+		Amount:      amount,
+		Description: description,
+	}, nil
+}
+
+func ofxValue(block, tag string) string {
+	upper := strings.ToUpper(block)
+	i := strings.Index(upper, "<"+tag+">")
+	if i == -1 {
+		return ""
+	}
+	rest := block[i+len(tag)+2:]
+	if j := strings.IndexAny(rest, "<\r\n"); j != -1 {
+		rest = rest[:j]
+	}
+	return strings.TrimSpace(unescapeOFX(rest))
+}
+
+func unescapeOFX(s string) string {
+	return strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&", "&quot;", `"`, "&apos;", "'").Replace(s)
+}
+
+// parseOFXDate reads YYYYMMDD[HHMMSS[.XXX]][[+|-]H[:TZNAME]]. The timezone
+// offset is in hours and defaults to UTC.
+func parseOFXDate(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	loc := time.UTC
+	if i := strings.Index(s, "["); i != -1 {
+		tz := strings.TrimSuffix(s[i+1:], "]")
+		tz, _, _ = strings.Cut(tz, ":")
+		var hours float64
+		if _, err := fmt.Sscanf(tz, "%g", &hours); err == nil {
+			loc = time.FixedZone("", int(hours*3600))
+		}
+		s = s[:i]
+	}
+	if i := strings.Index(s, "."); i != -1 {
+		s = s[:i]
+	}
+	for _, layout := range []string{"20060102150405", "200601021504", "20060102"} {
+		if len(s) != len(layout) {
+			continue
+		}
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid ofx date %q", s)
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	model "manager/internal/models"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	FormatCSV        = "csv"
+	FormatOFX        = "ofx"
+	FormatClientBank = "1c"
+)
+
+var ErrUnknownFormat = errors.New("unknown statement format")
+
+// Row is a single parsed statement line. Line is 1-based and points at the
+// line (CSV) or record (OFX, 1C) of the source file. Err is set when the line
+// could not be turned into a transaction.
+type Row struct {
+	Line        int
+	Transaction model.TransactionMl
+	Err         error
+}
+
+// Parse decodes a bank statement and returns the format it was read as. An
+// empty format is detected from the content. Files that are not valid UTF-8
+// are treated as Windows-1251, which is what 1C and most Russian banks export.
+func Parse(format string, data []byte) (string, []Row, error) {
+	text, err := decode(data)
+	if err != nil {
+		return "", nil, err
+	}
+	if format == "" {
+		format = detect(text)
+	}
+	format = strings.ToLower(format)
+	var rows []Row
+	switch format {
+	case FormatCSV:
+		rows, err = parseCSV(text)
+	case FormatOFX:
+		rows, err = parseOFX(text)
+	case FormatClientBank:
+		rows, err = parseClientBank(text)
+	default:
+		err = ErrUnknownFormat
+	}
+	return format, rows, err
+}
+
+func detect(text string) string {
+	head := strings.TrimSpace(text)
+	if len(head) > 512 {
+		head = head[:512]
+	}
+	switch {
+	case strings.HasPrefix(head, "1CClientBankExchange"):
+		return FormatClientBank
+	case strings.Contains(head, "OFXHEADER"), strings.Contains(strings.ToUpper(head), "<OFX>"):
+		return FormatOFX
+	}
+	return FormatCSV
+}
+
+func decode(data []byte) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+	decoded, err := charmap.Windows1251.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("decode windows-1251: %w", err)
+	}
+	return string(decoded), nil
+}
+
+// parseAmount accepts "1 234,56", "1.234,56", "1,234.56" and "1234.56"
+// notations and returns the value rounded to whole roubles together with its
+// sign. With both separators the last one is the decimal separator; a single
+// kind of separator is a decimal one unless it repeats.
+func parseAmount(s string) (int64, bool, error) {
+	s = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "", "'", "").Replace(strings.TrimSpace(s))
+	decimal := strings.LastIndexAny(s, ",.")
+	if decimal >= 0 && strings.Count(s, s[decimal:decimal+1]) > 1 {
+		decimal = -1
+	}
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case i == decimal:
+			b.WriteByte('.')
+		case r == ',' || r == '.':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	s = b.String()
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid amount %q", s)
+	}
+	if v == 0 {
+		return 0, false, errors.New("zero amount")
+	}
+	return int64(math.Round(math.Abs(v))), v < 0, nil
+}
+
+var dateLayouts = []string{
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+	"02.01.2006",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	"2006-01-02",
+	"02/01/2006",
+}
+
+func parseDate(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid date %q", s)
+}
+
+func transactionType(negative bool) string {
+	if negative {
+		return model.TypeExpense
+	}
+	return model.TypeIncome
+}
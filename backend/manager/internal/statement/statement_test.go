@@ -0,0 +1,103 @@
+package statement
+
+import "testing"
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		amount   int64
+		negative bool
+	}{
+		{"sber csv", "1 234,56", 1235, false},
+		{"sber csv nbsp", "-1 234,56", 1235, true},
+		{"tinkoff csv", "-1234,56", 1235, true},
+		{"alfa csv", "1.234,56", 1235, false},
+		{"alfa csv millions", "-1.234.567,89", 1234568, true},
+		{"english thousands", "1,234.56", 1235, false},
+		{"english millions", "1,234,567.89", 1234568, false},
+		{"ofx", "-1234.56", 1235, true},
+		{"1c clientbank", "150000.00", 150000, false},
+		{"thousands only", "1.234.567", 1234567, false},
+		{"plain", "250", 250, false},
+		{"apostrophe thousands", "1'234.50", 1235, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, negative, err := parseAmount(tt.in)
+			if err != nil {
+				t.Fatalf("parseAmount(%q): %v", tt.in, err)
+			}
+			if amount != tt.amount || negative != tt.negative {
+				t.Errorf("parseAmount(%q) = %d, %v, want %d, %v", tt.in, amount, negative, tt.amount, tt.negative)
+			}
+		})
+	}
+}
+
+func TestParseAmountInvalid(t *testing.T) {
+	for _, in := range []string{"", "abc", "0", "0,00", "12,3a"} {
+		if _, _, err := parseAmount(in); err == nil {
+			t.Errorf("parseAmount(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		want   []int64
+	}{
+		{
+			name:   "sber csv",
+			format: FormatCSV,
+			data:   "Дата операции;Сумма операции;Описание операции\n01.03.2025 12:30;-1 234,56;Пятёрочка\n02.03.2025;90 000,00;Зарплата\n",
+			want:   []int64{1235, 90000},
+		},
+		{
+			name:   "alfa csv",
+			format: FormatCSV,
+			data:   "Дата;Расход;Приход;Описание\n01.03.2025;1.234,56;;Перекрёсток\n02.03.2025;;2.500,00;Кэшбэк\n",
+			want:   []int64{1235, 2500},
+		},
+		{
+			name:   "english csv",
+			format: FormatCSV,
+			data:   "Date,Amount,Description\n2025-03-01,\"-1,234.56\",Grocery\n",
+			want:   []int64{1235},
+		},
+		{
+			name:   "ofx",
+			format: FormatOFX,
+			data:   "OFXHEADER:100\n<OFX><BANKTRANLIST><STMTTRN><DTPOSTED>20250301<TRNAMT>-1234.56<NAME>Grocery</STMTTRN></BANKTRANLIST></OFX>\n",
+			want:   []int64{1235},
+		},
+		{
+			name:   "1c clientbank",
+			format: FormatClientBank,
+			data:   "1CClientBankExchange\nРасчСчет=40702810000000000001\nСекцияДокумент=Платежное поручение\nДата=01.03.2025\nСумма=1234.56\nПлательщикСчет=40702810000000000001\nПолучатель=ООО Ромашка\nКонецДокумента\n",
+			want:   []int64{1235},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, rows, err := Parse(tt.format, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.want))
+			}
+			for i, row := range rows {
+				if row.Err != nil {
+					t.Fatalf("row %d: %v", i, row.Err)
+				}
+				if row.Transaction.Amount != tt.want[i] {
+					t.Errorf("row %d amount = %d, want %d", i, row.Transaction.Amount, tt.want[i])
+				}
+			}
+		})
+	}
+}
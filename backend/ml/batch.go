@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxBatchTransactions caps a single batch request; the manager splits larger
+// sets itself.
+const maxBatchTransactions = 500
+
+// batchAnswer is the JSON document the model is constrained to. Index is the
+// 1-based number of the transaction in the prompt.
+type batchAnswer struct {
+	Results []struct {
+		Index      int     `json:"index"`
+		Kategoria  string  `json:"kategoria"`
+		Confidence float64 `json:"confidence"`
+	} `json:"results"`
+}
+
+// handleCategorizeBatch categorises the transactions CategorizeBatchSize at a
+// time, one prompt per chunk. Transactions the model skips or answers with an
+// unknown category get the fallback category with confidence 0.
+func handleCategorizeBatch(c *gin.Context) {
+	var req BatchCategorizeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.Transactions) > maxBatchTransactions {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("at most %d transactions per batch", maxBatchTransactions)})
+		return
+	}
+	categories := req.Categories
+	if len(categories) == 0 {
+		categories = DefaultCategories
+	}
+	fallback := fallbackCategory(categories)
+	systemPrompt := batchSystemPrompt(categories, req.Examples, fallback)
+	schema := batchSchema(categories)
+
+	results := make([]BatchCategorizeResult, len(req.Transactions))
+	for i := range results {
+		results[i] = BatchCategorizeResult{Kategoria: fallback}
+	}
+	for start := 0; start < len(req.Transactions); start += CategorizeBatchSize {
+		chunk := req.Transactions[start:min(start+CategorizeBatchSize, len(req.Transactions))]
+		messages := []Message{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: batchUserPrompt(chunk)},
+		}
+		raw, err := llm.Categorize.CompleteJSON(c.Request.Context(), messages, 0.0, schema)
+		if err != nil {
+			engineError(c, err)
+			return
+		}
+		var answer batchAnswer
+		if err := json.Unmarshal([]byte(raw), &answer); err != nil {
+			log.Printf("Model returned invalid JSON for a batch of %d: %v. Fallback to %s.", len(chunk), err, fallback)
+			continue
+		}
+		seen := make(map[int]bool, len(chunk))
+		for _, r := range answer.Results {
+			i := r.Index - 1
+			if i < 0 || i >= len(chunk) || seen[i] {
+				continue
+			}
+			kategoria, ok := isValidCategory(r.Kategoria, categories)
+			if !ok {
+				log.Printf("Model hallucinated: %s. Fallback to %s.", r.Kategoria, fallback)
+				continue
+			}
+			seen[i] = true
+			results[start+i] = BatchCategorizeResult{Kategoria: kategoria, Confidence: min(max(r.Confidence, 0), 1)}
+		}
+	}
+
+	c.JSON(http.StatusOK, BatchCategorizeResponse{Results: results})
+}
+
+func batchSystemPrompt(categories []string, examples []Example, fallback string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `You are a strict data classification machine.
+You will receive a numbered list of transactions.
+For every transaction return its number as "index", the category name from the list below that best fits it as "kategoria", written exactly as in the list, and your confidence from 0 to 1 as "confidence".
+Allowed categories: [%s].
+If you cannot decide, use "%s" with a low confidence.`, quoteCategories(categories), fallback)
+	var shown int
+	for _, ex := range examples {
+		kategoria, ok := isValidCategory(ex.Kategoria, categories)
+		if !ok {
+			continue
+		}
+		if shown == 0 {
+			b.WriteString("\nThe user categorized these transactions themselves:")
+		}
+		shown++
+		fmt.Fprintf(&b, "\n- %s -> %s", transactionPrompt(ex.Description, ex.Amount, ex.Type), kategoria)
+	}
+	return b.String()
+}
+
+func batchUserPrompt(transactions []Transaction) string {
+	lines := make([]string, len(transactions))
+	for i, t := range transactions {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, transactionPrompt(t.Description, t.Amount, t.Type))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func batchSchema(categories []string) map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"results": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"index":      map[string]any{"type": "integer"},
+						"kategoria":  map[string]any{"type": "string", "enum": categories},
+						"confidence": map[string]any{"type": "number"},
+					},
+					"required":             []string{"index", "kategoria", "confidence"},
+					"additionalProperties": false,
+				},
+			},
+		},
+		"required":             []string{"results"},
+		"additionalProperties": false,
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	database "github.com/PrototypeSirius/protos_service/gen/cybergarden/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const historyTimeout = 5 * time.Second
+
+// HistoryStore keeps chat history in the database service, so conversations
+// survive restarts and are shared between ml replicas.
+type HistoryStore struct {
+	client database.DatabaseClient
+}
+
+func NewHistoryStore(addr string) (*HistoryStore, error) {
+	cc, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database service: %w", err)
+	}
+	return &HistoryStore{client: database.NewDatabaseClient(cc)}, nil
+}
+
+// Context returns the messages sent to the model for prompt: the system
+// prompt, the latest stored messages and prompt itself, ContextLimit in total.
+func (h *HistoryStore) Context(ctx context.Context, uid int64, prompt string) ([]Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, historyTimeout)
+	defer cancel()
+	resp, err := h.client.RequestChatMessages(ctx, &database.RequestChatMessagesRequest{
+		UserID: uid,
+		Limit:  int64(ContextLimit - 2),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat history: %w", err)
+	}
+	messages := make([]Message, 0, len(resp.GetMessages())+2)
+	messages = append(messages, Message{Role: "system", Content: chatSystemPrompt})
+	for _, m := range resp.GetMessages() {
+		messages = append(messages, Message{Role: m.GetRole(), Content: m.GetContent()})
+	}
+	return append(messages, Message{Role: "user", Content: prompt}), nil
+}
+
+// Save stores a question together with its answer, so a failed model call
+// leaves no unanswered message behind.
+func (h *HistoryStore) Save(ctx context.Context, uid int64, prompt, answer string) error {
+	ctx, cancel := context.WithTimeout(ctx, historyTimeout)
+	defer cancel()
+	_, err := h.client.AddChatMessages(ctx, &database.AddChatMessagesRequest{
+		UserID: uid,
+		Messages: []*database.ChatMessage{
+			{Role: "user", Content: prompt},
+			{Role: "assistant", Content: answer},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save chat history: %w", err)
+	}
+	return nil
+}
+
+func (h *HistoryStore) Clear(ctx context.Context, uid int64) error {
+	ctx, cancel := context.WithTimeout(ctx, historyTimeout)
+	defer cancel()
+	if _, err := h.client.ClearChatMessages(ctx, &database.ClearChatMessagesRequest{UserID: uid}); err != nil {
+		return fmt.Errorf("failed to clear chat history: %w", err)
+	}
+	return nil
+}
+
+func parseUserID(s string) (int64, error) {
+	uid, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || uid <= 0 {
+		return 0, fmt.Errorf("invalid user_id %q", s)
+	}
+	return uid, nil
+}
@@ -1,16 +1,15 @@
 package main
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
-	"sync"
-	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,78 +19,168 @@ var (
 	ModelName    = getEnv("MODEL_NAME", "gemma3")
 	ContextLimit = 10
 	Port         = getEnv("PORT", "8082")
+	DatabaseAddr = getEnv("DATABASE_ADDR", "database-service:2012")
+	// CategorizeBatchSize is how many transactions share one prompt in a
+	// batch categorization.
+	CategorizeBatchSize = getEnvInt("CATEGORIZE_BATCH_SIZE", 25)
+	// ToolsURL is the internal tools port of the manager; empty turns the
+	// chat tools off.
+	ToolsURL = getEnv("TOOLS_URL", "http://manager:8083")
+	// ToolsSecret is sent to the tools port, which refuses requests without
+	// the manager's TOOLS_SECRET.
+	ToolsSecret = getEnv("TOOLS_SECRET", "")
+	// LLMWorkers is how many model calls run at the same time; 0 turns the
+	// worker pool off.
+	LLMWorkers = getEnvCount("LLM_WORKERS", 2)
 )
 
-var AllowedCategories = []string{
+// DefaultCategories are offered when the request does not bring the user's
+// own categories.
+var DefaultCategories = []string{
 	"Misc", "Food", "Salary", "Shopping", "Electronics", "Restaurants", "Transport",
 }
 
-type ContextStore struct {
-	sync.RWMutex
-	History map[string][]OllamaMessage
-}
-
-var store = ContextStore{
-	History: make(map[string][]OllamaMessage),
-}
+var (
+	history    *HistoryStore
+	llm        Providers
+	toolClient *ToolClient
+	pool       *WorkerPool
+)
 
 const chatSystemPrompt = `You are a concise and strictly financial AI assistant. Your purpose is to help the user manage their personal finances.
 Your answers must be brief, limited to one or two sentences, and always focused on financial topics (expenses, savings, budget, etc.).
 Do NOT ask for context. Do NOT go off-topic. Do NOT use conversational fillers like "Hello" or "How can I help you?".`
 
+// maxReasonLength caps the reason the model gives for a category, in runes.
+const maxReasonLength = 200
+
+const adviceHistoryPrompt = "Проведен анализ транзакций для финансового совета."
+
 func main() {
+	var err error
+	history, err = NewHistoryStore(DatabaseAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	llm, err = LoadProviders()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if LLMWorkers > 0 {
+		pool = NewWorkerPool(LLMWorkers)
+		llm = pool.Queue(llm)
+	}
+	if ToolsURL != "" {
+		toolClient = NewToolClient(ToolsURL, ToolsSecret)
+	}
+
 	r := gin.Default()
 
 	r.POST("/api/categorize", handleCategorize)
 
+	r.POST("/api/categorize/batch", handleCategorizeBatch)
+
+	r.POST("/api/parse", handleParse)
+
 	r.POST("/api/chat", handleChat)
 
 	r.POST("/api/advice", handleAdvice)
 
 	r.DELETE("/api/context/:user_id", handleClearContext)
 
-	log.Printf("ML Service started on port %s using model %s", Port, ModelName)
+	r.GET("/api/queue", handleQueueStats)
+
+	log.Printf("ML Service started on port %s (categorize: %s, chat: %s, advice: %s, parse: %s, workers: %d)",
+		Port, llm.Categorize.Name(), llm.Chat.Name(), llm.Advice.Name(), llm.Parse.Name(), LLMWorkers)
 	r.Run(":" + Port)
 }
 
+// handleCategorize constrains the answer to categorizeSchema, so the category
+// can only be one of the allowed names. An answer that still does not parse
+// or names an unknown category yields the fallback category with confidence 0.
 func handleCategorize(c *gin.Context) {
 	var req CategorizeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	categoriesStr := strings.Join(AllowedCategories, ", ")
-	systemPrompt := fmt.Sprintf(`You are a strict data classification machine. 
-You will receive transaction details. 
-You must return ONLY one word: the category name from the list below that best fits the transaction.
+	categories := req.Categories
+	if len(categories) == 0 {
+		categories = DefaultCategories
+	}
+	fallback := fallbackCategory(categories)
+	systemPrompt := fmt.Sprintf(`You are a strict data classification machine.
+You will receive transaction details.
+Return the category name from the list below that best fits the transaction as "kategoria", written exactly as in the list, your confidence from 0 to 1 as "confidence" and a reason of at most ten words as "reason".
 Allowed categories: [%s].
-Do NOT write "The category is...", do NOT add punctuation. Return ONLY the category word.
-If you cannot decide, return "Misc".`, categoriesStr)
-
-	userPrompt := fmt.Sprintf("Transaction: %s, Amount: %d, Type: %s",
-		req.Transaction.Description, req.Transaction.Amount, req.Transaction.Type)
+If you cannot decide, use "%s" with a low confidence.`, quoteCategories(categories), fallback)
 
-	messages := []OllamaMessage{
-		{Role: "system", Content: systemPrompt},
-		{Role: "user", Content: userPrompt},
+	messages := []Message{{Role: "system", Content: systemPrompt}}
+	for _, ex := range req.Examples {
+		kategoria, ok := isValidCategory(ex.Kategoria, categories)
+		if !ok {
+			continue
+		}
+		answer, err := json.Marshal(CategorizeResponse{Kategoria: kategoria, Confidence: 1, Reason: "categorized by the user"})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		messages = append(messages,
+			Message{Role: "user", Content: transactionPrompt(ex.Description, ex.Amount, ex.Type)},
+			Message{Role: "assistant", Content: string(answer)},
+		)
 	}
+	messages = append(messages, Message{Role: "user", Content: transactionPrompt(req.Transaction.Description, req.Transaction.Amount, req.Transaction.Type)})
 
-	category, err := callOllama(messages, 0.0)
+	raw, err := llm.Categorize.CompleteJSON(c.Request.Context(), messages, 0.0, categorizeSchema(categories))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "AI Engine error", "details": err.Error()})
+		engineError(c, err)
 		return
 	}
 
-	cleanCategory := cleanResponse(category)
+	answer, err := parseCategorization(raw, categories)
+	if err != nil {
+		log.Printf("Model gave an unusable answer: %v. Fallback to %s.", err, fallback)
+		answer = CategorizeResponse{Kategoria: fallback}
+	}
+
+	c.JSON(http.StatusOK, answer)
+}
 
-	if !isValidCategory(cleanCategory) {
-		log.Printf("Model hallucinated: %s. Fallback to Misc.", cleanCategory)
-		cleanCategory = "Misc"
+func categorizeSchema(categories []string) map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"kategoria":  map[string]any{"type": "string", "enum": categories},
+			"confidence": map[string]any{"type": "number"},
+			"reason":     map[string]any{"type": "string"},
+		},
+		"required":             []string{"kategoria", "confidence", "reason"},
+		"additionalProperties": false,
 	}
+}
 
-	c.JSON(http.StatusOK, CategorizeResponse{
-		Kategoria: cleanCategory,
-	})
+// parseCategorization accepts only a single JSON object naming one of the
+// categories. The category is returned spelled as in the list.
+func parseCategorization(raw string, categories []string) (CategorizeResponse, error) {
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var answer CategorizeResponse
+	if err := dec.Decode(&answer); err != nil {
+		return CategorizeResponse{}, fmt.Errorf("invalid JSON %q: %w", raw, err)
+	}
+	if dec.More() {
+		return CategorizeResponse{}, fmt.Errorf("trailing data after JSON %q", raw)
+	}
+	kategoria, ok := isValidCategory(answer.Kategoria, categories)
+	if !ok {
+		return CategorizeResponse{}, fmt.Errorf("unknown category %q", answer.Kategoria)
+	}
+	answer.Kategoria = kategoria
+	answer.Confidence = min(max(answer.Confidence, 0), 1)
+	answer.Reason = truncateRunes(strings.TrimSpace(answer.Reason), maxReasonLength)
+	return answer, nil
 }
 
 func handleChat(c *gin.Context) {
@@ -101,33 +190,50 @@ func handleChat(c *gin.Context) {
 		return
 	}
 
-	store.Lock()
-	if _, exists := store.History[req.UserID]; !exists {
-		store.History[req.UserID] = []OllamaMessage{{Role: "system", Content: chatSystemPrompt}}
+	uid, err := parseUserID(req.UserID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	store.History[req.UserID] = append(store.History[req.UserID], OllamaMessage{Role: "user", Content: req.Prompt})
-
-	if len(store.History[req.UserID]) > ContextLimit {
-		systemMsg := store.History[req.UserID][0]
-		store.History[req.UserID] = store.History[req.UserID][len(store.History[req.UserID])-ContextLimit+1:]
-		store.History[req.UserID] = append([]OllamaMessage{systemMsg}, store.History[req.UserID]...)
+	currentContext, err := history.Context(c.Request.Context(), uid, req.Prompt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "History error", "details": err.Error()})
+		return
 	}
 
-	currentContext := make([]OllamaMessage, len(store.History[req.UserID]))
-	copy(currentContext, store.History[req.UserID])
-	store.Unlock()
+	tools := chatTools(c.Request.Context())
+	if req.Stream {
+		stream := func(onChunk func(string) error) (string, error) {
+			return llm.Chat.Stream(c.Request.Context(), currentContext, 0.5, onChunk)
+		}
+		if len(tools) > 0 {
+			stream = func(onChunk func(string) error) (string, error) {
+				return streamWithTools(c.Request.Context(), llm.Chat, uid, currentContext, tools, 0.5, onChunk)
+			}
+		}
+		streamAnswer(c, stream, func(answer string) {
+			if err := history.Save(c.Request.Context(), uid, req.Prompt, answer); err != nil {
+				log.Printf("Failed to save chat history of user %d: %v", uid, err)
+			}
+		})
+		return
+	}
 
-	responseContent, err := callOllama(currentContext, 0.5)
+	var responseContent string
+	if len(tools) > 0 {
+		responseContent, err = answerWithTools(c.Request.Context(), llm.Chat, uid, currentContext, tools, 0.5)
+	} else {
+		responseContent, err = llm.Chat.Complete(c.Request.Context(), currentContext, 0.5)
+	}
 	if err != nil {
-		rollbackLastMessage(req.UserID)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "AI Engine error", "details": err.Error()})
+		engineError(c, err)
 		return
 	}
 
-	store.Lock()
-	store.History[req.UserID] = append(store.History[req.UserID], OllamaMessage{Role: "assistant", Content: responseContent})
-	store.Unlock()
+	if err := history.Save(c.Request.Context(), uid, req.Prompt, responseContent); err != nil {
+		log.Printf("Failed to save chat history of user %d: %v", uid, err)
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"response": responseContent,
@@ -140,106 +246,166 @@ func handleAdvice(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	uid, err := parseUserID(req.UserID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	systemPrompt := `You are a world-class financial consultant. You will receive a JSON string containing a list of the user's financial transactions. Each transaction includes the following fields: "id", "date" (timestamp), "kategoria" (category), "type" (Пополнение/Доход or Списание/Покупка), "amount" (RUB), and "description". Your task is to aggressively analyze this data and deliver strict, actionable, data-driven financial recommendations. Focus on identifying spending patterns, exposing wasteful expenses, finding concrete ways to cut costs, and giving clear guidance on increasing savings or managing debt. The response must be written in Russian, in a polite, friendly, but firm and highly professional tone. You must output ONLY truthful insights grounded strictly in the provided data — fabricating or assuming anything not supported by the JSON is strictly forbidden. Do NOT include raw JSON in the final answer; summarize the findings concisely. If the transaction list is empty, explicitly state that no data is available and advise the user to begin tracking their expenses.
+	systemPrompt := `You are a world-class financial consultant. You will receive a JSON string containing a list of the user's financial transactions. Each transaction includes the following fields: "id", "date" (timestamp), "kategoria" (category), "type" (Пополнение/Доход or Списание/Покупка), "amount" (in the transaction's "currency"), "base_amount" (the amount converted to RUB, absent when no exchange rate is known) and "description". Compare and total amounts using "base_amount" whenever it is present. Your task is to aggressively analyze this data and deliver strict, actionable, data-driven financial recommendations. Focus on identifying spending patterns, exposing wasteful expenses, finding concrete ways to cut costs, and giving clear guidance on increasing savings or managing debt. The response must be written in Russian, in a polite, friendly, but firm and highly professional tone. You must output ONLY truthful insights grounded strictly in the provided data — fabricating or assuming anything not supported by the JSON is strictly forbidden. Do NOT include raw JSON in the final answer; summarize the findings concisely. If the transaction list is empty, explicitly state that no data is available and advise the user to begin tracking their expenses.
 `
 	userPrompt := fmt.Sprintf("Analyze the following JSON list of transactions and provide financial advice:\n\n%s", req.Transactions)
 
-	messages := []OllamaMessage{
+	messages := []Message{
 		{Role: "system", Content: systemPrompt},
 		{Role: "user", Content: userPrompt},
 	}
 
-	adviceContent, err := callOllama(messages, 0.8)
+	if req.Stream {
+		stream := func(onChunk func(string) error) (string, error) {
+			return llm.Advice.Stream(c.Request.Context(), messages, 0.8, onChunk)
+		}
+		streamAnswer(c, stream, func(answer string) {
+			if err := history.Save(c.Request.Context(), uid, adviceHistoryPrompt, answer); err != nil {
+				log.Printf("Failed to save chat history of user %d: %v", uid, err)
+			}
+		})
+		return
+	}
+
+	adviceContent, err := llm.Advice.Complete(c.Request.Context(), messages, 0.8)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "AI Engine error", "details": err.Error()})
+		engineError(c, err)
 		return
 	}
 
-	store.Lock()
-	if _, exists := store.History[req.UserID]; !exists {
-		store.History[req.UserID] = []OllamaMessage{{Role: "system", Content: chatSystemPrompt}}
+	if err := history.Save(c.Request.Context(), uid, adviceHistoryPrompt, adviceContent); err != nil {
+		log.Printf("Failed to save chat history of user %d: %v", uid, err)
 	}
-	store.History[req.UserID] = append(store.History[req.UserID], OllamaMessage{Role: "user", Content: "Проведен анализ транзакций для финансового совета."})
-	store.History[req.UserID] = append(store.History[req.UserID], OllamaMessage{Role: "assistant", Content: adviceContent})
-	store.Unlock()
 
 	c.JSON(http.StatusOK, AdviceResponse{
 		Advice: adviceContent,
 	})
 }
 
-func callOllama(messages []OllamaMessage, temp float64) (string, error) {
-	reqData := OllamaRequest{
-		Model:    ModelName,
-		Messages: messages,
-		Stream:   false,
-		Options:  map[string]interface{}{"temperature": temp},
+// chatTools returns the tools the chat model may call. Without them, because
+// they are turned off or the manager cannot be reached, the chat still works
+// from the history alone.
+func chatTools(ctx context.Context) []Tool {
+	if toolClient == nil {
+		return nil
 	}
-
-	jsonData, err := json.Marshal(reqData)
+	tools, err := toolClient.Tools(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal Ollama request: %w", err)
+		log.Printf("Chat tools are unavailable: %v", err)
+		return nil
 	}
-	client := &http.Client{Timeout: 1200 * time.Second} // Используем увеличенный таймаут
+	return tools
+}
 
-	resp, err := client.Post(OllamaURL, "application/json", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", fmt.Errorf("failed to call Ollama: %w", err)
+// streamAnswer relays the answer of stream to the client as NDJSON chunks.
+// onDone receives the whole answer before the final chunk is sent and is
+// skipped if the model fails or the client goes away. A failure before the
+// first chunk, such as a full queue, is answered like a plain request.
+func streamAnswer(c *gin.Context, stream func(onChunk func(string) error) (string, error), onDone func(answer string)) {
+	started := false
+	start := func() {
+		if !started {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Header("Cache-Control", "no-cache")
+			c.Status(http.StatusOK)
+			started = true
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, readErr := io.ReadAll(resp.Body)
-		if readErr != nil {
-			return "", fmt.Errorf("ollama status %d, failed to read response body: %w", resp.StatusCode, readErr)
+	answer, err := stream(func(content string) error {
+		start()
+		return writeChunk(c, StreamChunk{Content: content})
+	})
+	if err != nil && !started {
+		engineError(c, err)
+		return
+	}
+	if err != nil {
+		if c.Request.Context().Err() == nil {
+			log.Printf("Streaming failed: %v", err)
+			_ = writeChunk(c, StreamChunk{Done: true, Error: err.Error()})
 		}
-		return "", fmt.Errorf("ollama status %d: %s", resp.StatusCode, string(body))
+		return
 	}
+	start()
+	onDone(answer)
+	_ = writeChunk(c, StreamChunk{Done: true})
+}
 
-	var ollamaResp OllamaResponse
-	if err := json.NewDecoder(resp.Body).Decode(&ollamaResp); err != nil {
-		return "", fmt.Errorf("failed to decode Ollama response: %w", err)
+// engineError answers a failed model call. A full queue gets 503 with
+// Retry-After, so the caller backs off instead of piling up.
+func engineError(c *gin.Context, err error) {
+	if errors.Is(err, ErrQueueFull) {
+		c.Header("Retry-After", strconv.Itoa(queueRetryAfter))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "AI Engine busy", "details": err.Error()})
+		return
 	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "AI Engine error", "details": err.Error()})
+}
 
-	return ollamaResp.Message.Content, nil
+func handleQueueStats(c *gin.Context) {
+	if pool == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "worker pool is off"})
+		return
+	}
+	c.JSON(http.StatusOK, pool.Stats())
+}
+
+func writeChunk(c *gin.Context, chunk StreamChunk) error {
+	if err := json.NewEncoder(c.Writer).Encode(chunk); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
 }
 
 func handleClearContext(c *gin.Context) {
-	userID := c.Param("user_id")
-	store.Lock()
-	delete(store.History, userID)
-	store.Unlock()
+	uid, err := parseUserID(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := history.Clear(c.Request.Context(), uid); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "History error", "details": err.Error()})
+		return
+	}
 	c.JSON(http.StatusOK, gin.H{"status": "cleared"})
 }
 
-func rollbackLastMessage(userID string) {
-	store.Lock()
-	defer store.Unlock()
-	if len(store.History[userID]) > 0 {
-		store.History[userID] = store.History[userID][:len(store.History[userID])-1]
+func transactionPrompt(description string, amount int64, typ string) string {
+	return fmt.Sprintf("Transaction: %s, Amount: %d, Type: %s", description, amount, typ)
+}
+
+func isValidCategory(cat string, categories []string) (string, bool) {
+	for _, c := range categories {
+		if strings.EqualFold(c, cat) {
+			return c, true
+		}
 	}
+	return "", false
 }
 
-func cleanResponse(input string) string {
-	res := strings.TrimSpace(input)
-	res = strings.Trim(res, ".")
-	res = strings.Trim(res, "\"")
-	res = strings.Trim(res, "'")
-	parts := strings.Fields(res)
-	if len(parts) > 0 {
-		return parts[0]
+// fallbackCategory is "Misc" when the user has it and the first category
+// otherwise.
+func fallbackCategory(categories []string) string {
+	if cat, ok := isValidCategory("Misc", categories); ok {
+		return cat
 	}
-	return "Misc"
+	return categories[0]
 }
 
-func isValidCategory(cat string) bool {
-	for _, c := range AllowedCategories {
-		if strings.EqualFold(c, cat) {
-			return true
-		}
+func quoteCategories(categories []string) string {
+	quoted := make([]string, len(categories))
+	for i, c := range categories {
+		quoted[i] = strconv.Quote(c)
 	}
-	return false
+	return strings.Join(quoted, ", ")
 }
 
 func getEnv(key, fallback string) string {
@@ -248,3 +414,20 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// getEnvCount is getEnvInt for settings where 0 is a meaningful value.
+func getEnvCount(key string, fallback int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
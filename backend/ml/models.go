@@ -1,5 +1,7 @@
 package main
 
+import "encoding/json"
+
 type Transaction struct {
 	Date        int64  `json:"date"`
 	Type        string `json:"type"`
@@ -10,39 +12,192 @@ type Transaction struct {
 type CategorizeRequest struct {
 	UserID      string      `json:"user_id" binding:"required"`
 	Transaction Transaction `json:"transaction" binding:"required"`
+	Categories  []string    `json:"categories"`
+	Examples    []Example   `json:"examples"`
+}
+
+// Example is a transaction the user has categorized by hand, shown to the
+// model before the transaction to categorize.
+type Example struct {
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Amount      int64  `json:"amount"`
+	Kategoria   string `json:"kategoria"`
 }
 
 type ChatRequest struct {
 	UserID string `json:"user_id" binding:"required"`
 	Prompt string `json:"prompt" binding:"required"`
+	Stream bool   `json:"stream"`
 }
 
 type AdviceRequest struct {
 	UserID       string `json:"user_id" binding:"required"`
 	Transactions string `json:"transactions" binding:"required"`
+	Stream       bool   `json:"stream"`
+}
+
+// ParseRequest asks for the transactions written in Text. Relative dates are
+// resolved against Now, epoch milliseconds defaulting to the current time, in
+// Timezone, an IANA zone defaulting to UTC.
+type ParseRequest struct {
+	UserID   string `json:"user_id" binding:"required"`
+	Text     string `json:"text" binding:"required"`
+	Timezone string `json:"timezone"`
+	Now      int64  `json:"now"`
+}
+
+type ParseResponse struct {
+	Transactions []ParsedTransaction `json:"transactions"`
+}
+
+// ParsedTransaction has an empty Currency when the text does not name one.
+type ParsedTransaction struct {
+	Date        int64  `json:"date"`
+	Type        string `json:"type"`
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+	Currency    string `json:"currency,omitempty"`
+}
+
+// StreamChunk is one NDJSON line of a streamed answer. The last chunk has
+// Done set and carries Error if the model failed midway.
+type StreamChunk struct {
+	Content string `json:"content,omitempty"`
+	Done    bool   `json:"done"`
+	Error   string `json:"error,omitempty"`
+}
+
+type BatchCategorizeRequest struct {
+	UserID       string        `json:"user_id" binding:"required"`
+	Transactions []Transaction `json:"transactions" binding:"required,min=1"`
+	Categories   []string      `json:"categories"`
+	Examples     []Example     `json:"examples"`
+}
+
+// BatchCategorizeResponse holds one result per transaction of the request,
+// in the same order.
+type BatchCategorizeResponse struct {
+	Results []BatchCategorizeResult `json:"results"`
 }
 
+// BatchCategorizeResult has Confidence 0 when the model gave no usable answer
+// for the transaction and the fallback category was used.
+type BatchCategorizeResult struct {
+	Kategoria  string  `json:"kategoria"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategorizeResponse is also the JSON document the model answers with.
+// Confidence is 0 when the fallback category was used.
 type CategorizeResponse struct {
-	Kategoria string `json:"kategoria"`
+	Kategoria  string  `json:"kategoria"`
+	Confidence float64 `json:"confidence"`
+	Reason     string  `json:"reason"`
 }
 
 type AdviceResponse struct {
 	Advice string `json:"advice"`
 }
 
-type OllamaMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+// Message is sent to the providers as is, so the tool fields follow the
+// Ollama wire format; openAIMessages converts them for OpenAI-compatible APIs.
+type Message struct {
+	Role       string     `json:"role"`
+	Content    string     `json:"content"`
+	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string     `json:"tool_call_id,omitempty"`
+	ToolName   string     `json:"tool_name,omitempty"`
+}
+
+type ToolCall struct {
+	ID       string           `json:"id,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Function ToolCallFunction `json:"function"`
+}
+
+// ToolCallFunction holds the arguments as a JSON object.
+type ToolCallFunction struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// Tool is a function the chat model may call, as the manager describes it.
+type Tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Parameters  map[string]any `json:"parameters"`
+}
+
+type ToolSpec struct {
+	Type     string `json:"type"`
+	Function Tool   `json:"function"`
 }
 
 type OllamaRequest struct {
 	Model    string                 `json:"model"`
-	Messages []OllamaMessage        `json:"messages"`
+	Messages []Message              `json:"messages"`
 	Stream   bool                   `json:"stream"`
+	Format   map[string]any         `json:"format,omitempty"`
+	Tools    []ToolSpec             `json:"tools,omitempty"`
 	Options  map[string]interface{} `json:"options,omitempty"`
 }
 
 type OllamaResponse struct {
-	Message OllamaMessage `json:"message"`
-	Done    bool          `json:"done"`
+	Message Message `json:"message"`
+	Done    bool    `json:"done"`
+	Error   string  `json:"error,omitempty"`
+}
+
+type OpenAIRequest struct {
+	Model          string                `json:"model,omitempty"`
+	Messages       []Message             `json:"messages"`
+	Temperature    float64               `json:"temperature"`
+	Stream         bool                  `json:"stream"`
+	CachePrompt    bool                  `json:"cache_prompt,omitempty"`
+	ResponseFormat *OpenAIResponseFormat `json:"response_format,omitempty"`
+	Tools          []ToolSpec            `json:"tools,omitempty"`
+}
+
+type OpenAIResponseFormat struct {
+	Type       string           `json:"type"`
+	JSONSchema OpenAIJSONSchema `json:"json_schema"`
+}
+
+type OpenAIJSONSchema struct {
+	Name   string         `json:"name"`
+	Schema map[string]any `json:"schema"`
+	Strict bool           `json:"strict"`
+}
+
+type OpenAIResponse struct {
+	Choices []struct {
+		Message Message `json:"message"`
+		Delta   Message `json:"delta"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// QueueStats reports the worker pool in front of the models.
+type QueueStats struct {
+	Workers int              `json:"workers"`
+	Busy    int              `json:"busy"`
+	Queues  []TaskQueueStats `json:"queues"`
+}
+
+// TaskQueueStats counts the calls of one task since the service started.
+// AvgWaitMs is the mean time a started call spent in the queue.
+type TaskQueueStats struct {
+	Task      string `json:"task"`
+	Priority  int    `json:"priority"`
+	Limit     int    `json:"limit"`
+	Waiting   int    `json:"waiting"`
+	Running   int    `json:"running"`
+	Started   int64  `json:"started"`
+	Finished  int64  `json:"finished"`
+	Rejected  int64  `json:"rejected"`
+	Canceled  int64  `json:"canceled"`
+	AvgWaitMs int64  `json:"avg_wait_ms"`
 }
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ollamaProvider talks to the Ollama /api/chat endpoint, which streams the
+// answer as NDJSON.
+type ollamaProvider struct {
+	url    string
+	model  string
+	client *http.Client
+}
+
+func newOllamaProvider(url, model string) *ollamaProvider {
+	return &ollamaProvider{
+		url:    url,
+		model:  model,
+		client: &http.Client{Timeout: providerTimeout},
+	}
+}
+
+func (p *ollamaProvider) Name() string {
+	return fmt.Sprintf("%s/%s", ProviderOllama, p.model)
+}
+
+func (p *ollamaProvider) Complete(ctx context.Context, messages []Message, temp float64) (string, error) {
+	answer, err := p.complete(ctx, OllamaRequest{Messages: messages}, temp)
+	return answer.Content, err
+}
+
+// CompleteJSON passes schema as the format of the answer, which Ollama
+// enforces while sampling.
+func (p *ollamaProvider) CompleteJSON(ctx context.Context, messages []Message, temp float64, schema map[string]any) (string, error) {
+	answer, err := p.complete(ctx, OllamaRequest{Messages: messages, Format: schema}, temp)
+	return answer.Content, err
+}
+
+func (p *ollamaProvider) CompleteTools(ctx context.Context, messages []Message, temp float64, tools []Tool) (Message, error) {
+	return p.complete(ctx, OllamaRequest{Messages: messages, Tools: toolSpecs(tools)}, temp)
+}
+
+func (p *ollamaProvider) complete(ctx context.Context, reqData OllamaRequest, temp float64) (Message, error) {
+	resp, err := p.do(ctx, reqData, temp)
+	if err != nil {
+		return Message{}, err
+	}
+	defer resp.Body.Close()
+
+	var ollamaResp OllamaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ollamaResp); err != nil {
+		return Message{}, fmt.Errorf("failed to decode Ollama response: %w", err)
+	}
+	return ollamaResp.Message, nil
+}
+
+func (p *ollamaProvider) Stream(ctx context.Context, messages []Message, temp float64, onChunk func(string) error) (string, error) {
+	resp, err := p.do(ctx, OllamaRequest{Messages: messages, Stream: true}, temp)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var answer strings.Builder
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var chunk OllamaResponse
+		if err := dec.Decode(&chunk); err != nil {
+			return "", fmt.Errorf("failed to decode Ollama stream: %w", err)
+		}
+		if chunk.Error != "" {
+			return "", errors.New(chunk.Error)
+		}
+		if chunk.Message.Content != "" {
+			answer.WriteString(chunk.Message.Content)
+			if err := onChunk(chunk.Message.Content); err != nil {
+				return "", err
+			}
+		}
+		if chunk.Done {
+			return answer.String(), nil
+		}
+	}
+}
+
+func (p *ollamaProvider) do(ctx context.Context, reqData OllamaRequest, temp float64) (*http.Response, error) {
+	reqData.Model = p.model
+	reqData.Options = map[string]interface{}{"temperature": temp}
+
+	jsonData, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Ollama request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Ollama request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Ollama: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return nil, fmt.Errorf("ollama status %d, failed to read response body: %w", resp.StatusCode, readErr)
+		}
+		return nil, fmt.Errorf("ollama status %d: %s", resp.StatusCode, string(body))
+	}
+	return resp, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// openAIProvider talks to any OpenAI-compatible /v1/chat/completions
+// endpoint, which streams the answer as Server-Sent Events.
+type openAIProvider struct {
+	kind        string
+	url         string
+	model       string
+	apiKey      string
+	cachePrompt bool
+	client      *http.Client
+}
+
+func newOpenAIProvider(url, model, apiKey string) *openAIProvider {
+	return &openAIProvider{
+		kind:   ProviderOpenAI,
+		url:    url,
+		model:  model,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: providerTimeout},
+	}
+}
+
+// newLlamaCppProvider talks to the OpenAI-compatible API of the llama.cpp
+// server. The server runs a single model, so model is only reported, and
+// cache_prompt lets it reuse the KV cache of the shared system prompt.
+func newLlamaCppProvider(url, model, apiKey string) *openAIProvider {
+	p := newOpenAIProvider(url, model, apiKey)
+	p.kind = ProviderLlamaCpp
+	p.cachePrompt = true
+	return p
+}
+
+func (p *openAIProvider) Name() string {
+	return fmt.Sprintf("%s/%s", p.kind, p.model)
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, messages []Message, temp float64) (string, error) {
+	answer, err := p.complete(ctx, OpenAIRequest{Messages: messages, Temperature: temp})
+	return answer.Content, err
+}
+
+// CompleteJSON asks for a json_schema response format, which both OpenAI and
+// the llama.cpp server turn into a grammar for the answer.
+func (p *openAIProvider) CompleteJSON(ctx context.Context, messages []Message, temp float64, schema map[string]any) (string, error) {
+	answer, err := p.complete(ctx, OpenAIRequest{
+		Messages:    messages,
+		Temperature: temp,
+		ResponseFormat: &OpenAIResponseFormat{
+			Type:       "json_schema",
+			JSONSchema: OpenAIJSONSchema{Name: "answer", Schema: schema, Strict: true},
+		},
+	})
+	return answer.Content, err
+}
+
+func (p *openAIProvider) CompleteTools(ctx context.Context, messages []Message, temp float64, tools []Tool) (Message, error) {
+	answer, err := p.complete(ctx, OpenAIRequest{Messages: messages, Temperature: temp, Tools: toolSpecs(tools)})
+	if err != nil {
+		return Message{}, err
+	}
+	for i, call := range answer.ToolCalls {
+		// The arguments arrive as a string holding the JSON object.
+		var args string
+		if err := json.Unmarshal(call.Function.Arguments, &args); err != nil {
+			return Message{}, fmt.Errorf("failed to decode %s tool call arguments: %w", p.kind, err)
+		}
+		if args == "" {
+			args = "{}"
+		}
+		answer.ToolCalls[i].Function.Arguments = json.RawMessage(args)
+	}
+	return answer, nil
+}
+
+func (p *openAIProvider) complete(ctx context.Context, reqData OpenAIRequest) (Message, error) {
+	resp, err := p.do(ctx, reqData)
+	if err != nil {
+		return Message{}, err
+	}
+	defer resp.Body.Close()
+
+	var result OpenAIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Message{}, fmt.Errorf("failed to decode %s response: %w", p.kind, err)
+	}
+	if len(result.Choices) == 0 {
+		return Message{}, fmt.Errorf("%s returned no choices", p.kind)
+	}
+	return result.Choices[0].Message, nil
+}
+
+func (p *openAIProvider) Stream(ctx context.Context, messages []Message, temp float64, onChunk func(string) error) (string, error) {
+	resp, err := p.do(ctx, OpenAIRequest{Messages: messages, Temperature: temp, Stream: true})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var answer strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			return answer.String(), nil
+		}
+		var chunk OpenAIResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return "", fmt.Errorf("failed to decode %s stream: %w", p.kind, err)
+		}
+		if chunk.Error != nil {
+			return "", errors.New(chunk.Error.Message)
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+		content := chunk.Choices[0].Delta.Content
+		answer.WriteString(content)
+		if err := onChunk(content); err != nil {
+			return "", err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read %s stream: %w", p.kind, err)
+	}
+	return "", fmt.Errorf("%s stream ended without [DONE]", p.kind)
+}
+
+func (p *openAIProvider) do(ctx context.Context, reqData OpenAIRequest) (*http.Response, error) {
+	reqData.Model = p.model
+	reqData.Messages = openAIMessages(reqData.Messages)
+	reqData.CachePrompt = p.cachePrompt
+
+	jsonData, err := json.Marshal(reqData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s request: %w", p.kind, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s request: %w", p.kind, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", p.kind, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return nil, fmt.Errorf("%s status %d, failed to read response body: %w", p.kind, resp.StatusCode, readErr)
+		}
+		return nil, fmt.Errorf("%s status %d: %s", p.kind, resp.StatusCode, string(body))
+	}
+	return resp, nil
+}
+
+// openAIMessages converts the tool fields of messages to the OpenAI format,
+// which passes the arguments of a call as a string and has no tool_name.
+func openAIMessages(messages []Message) []Message {
+	converted := make([]Message, len(messages))
+	for i, m := range messages {
+		m.ToolName = ""
+		if len(m.ToolCalls) > 0 {
+			calls := make([]ToolCall, len(m.ToolCalls))
+			for j, call := range m.ToolCalls {
+				args, _ := json.Marshal(string(call.Function.Arguments))
+				call.Type = "function"
+				call.Function.Arguments = args
+				calls[j] = call
+			}
+			m.ToolCalls = calls
+		}
+		converted[i] = m
+	}
+	return converted
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The transaction types as the rest of the system stores them.
+const (
+	TypeIncome  = "Пополнение/Доход"
+	TypeExpense = "Списание/Покупка"
+)
+
+const (
+	maxParseText          = 2000
+	maxDescriptionLength  = 200
+	parseDateLayout       = "2006-01-02"
+	defaultParseTimezone  = "UTC"
+	parsedTransactionHour = 12
+)
+
+// parseAnswer is the JSON document the model is constrained to.
+type parseAnswer struct {
+	Transactions []struct {
+		Date        string  `json:"date"`
+		Type        string  `json:"type"`
+		Amount      float64 `json:"amount"`
+		Description string  `json:"description"`
+		Currency    string  `json:"currency"`
+	} `json:"transactions"`
+}
+
+// handleParse turns a sentence such as "coffee 250 yesterday" into
+// transactions for the user to confirm. Nothing is stored here.
+func handleParse(c *gin.Context) {
+	var req ParseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.Text) > maxParseText {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("text must be at most %d bytes", maxParseText)})
+		return
+	}
+	if req.Timezone == "" {
+		req.Timezone = defaultParseTimezone
+	}
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown timezone %q", req.Timezone)})
+		return
+	}
+	now := time.Now()
+	if req.Now != 0 {
+		now = time.UnixMilli(req.Now)
+	}
+	now = now.In(loc)
+
+	messages := []Message{
+		{Role: "system", Content: parseSystemPrompt(now)},
+		{Role: "user", Content: req.Text},
+	}
+	raw, err := llm.Parse.CompleteJSON(c.Request.Context(), messages, 0.0, parseSchema())
+	if err != nil {
+		engineError(c, err)
+		return
+	}
+	var answer parseAnswer
+	if err := json.Unmarshal([]byte(raw), &answer); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "AI Engine error", "details": fmt.Sprintf("invalid JSON %q: %v", raw, err)})
+		return
+	}
+
+	transactions := []ParsedTransaction{}
+	for _, t := range answer.Transactions {
+		date, err := time.ParseInLocation(parseDateLayout, t.Date, loc)
+		if err != nil {
+			log.Printf("Model returned an invalid date %q, transaction skipped.", t.Date)
+			continue
+		}
+		amount := int64(math.Round(math.Abs(t.Amount)))
+		if amount == 0 {
+			continue
+		}
+		parsed := ParsedTransaction{
+			Date:        parsedDate(date, now).UnixMilli(),
+			Type:        TypeExpense,
+			Amount:      amount,
+			Description: truncateRunes(strings.TrimSpace(t.Description), maxDescriptionLength),
+			Currency:    strings.ToUpper(strings.TrimSpace(t.Currency)),
+		}
+		if t.Type == "income" {
+			parsed.Type = TypeIncome
+		}
+		transactions = append(transactions, parsed)
+	}
+
+	c.JSON(http.StatusOK, ParseResponse{Transactions: transactions})
+}
+
+// parsedDate keeps the current time for transactions of today and puts the
+// others at noon, away from the day boundaries.
+func parsedDate(date, now time.Time) time.Time {
+	y, m, d := now.Date()
+	if date.Equal(time.Date(y, m, d, 0, 0, 0, 0, now.Location())) {
+		return now
+	}
+	return date.Add(parsedTransactionHour * time.Hour)
+}
+
+func parseSystemPrompt(now time.Time) string {
+	return fmt.Sprintf(`You extract financial transactions from a message the user wrote.
+Today is %s, %s.
+Return every transaction in the message with:
+- "date": the day it happened as YYYY-MM-DD; resolve words like "yesterday" or "last Friday" against today and use today when no day is given,
+- "type": "income" for money received, "expense" for money spent,
+- "amount": the positive amount as written,
+- "description": a few words saying what it was, in the language of the message,
+- "currency": the ISO 4217 code if the message names a currency, otherwise "".
+If the message has no transaction, return an empty list.`, now.Weekday(), now.Format(parseDateLayout))
+}
+
+func parseSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"transactions": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"date":        map[string]any{"type": "string"},
+						"type":        map[string]any{"type": "string", "enum": []string{"income", "expense"}},
+						"amount":      map[string]any{"type": "number"},
+						"description": map[string]any{"type": "string"},
+						"currency":    map[string]any{"type": "string"},
+					},
+					"required":             []string{"date", "type", "amount", "description", "currency"},
+					"additionalProperties": false,
+				},
+			},
+		},
+		"required":             []string{"transactions"},
+		"additionalProperties": false,
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	return string(r[:min(len(r), n)])
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ProviderOllama   = "ollama"
+	ProviderOpenAI   = "openai"
+	ProviderLlamaCpp = "llamacpp"
+
+	providerTimeout = 1200 * time.Second
+)
+
+// Provider is a chat model behind some inference API. Each provider is bound
+// to one model, so different tasks can use different models.
+type Provider interface {
+	Complete(ctx context.Context, messages []Message, temp float64) (string, error)
+	// CompleteJSON constrains the answer to a JSON document matching schema.
+	CompleteJSON(ctx context.Context, messages []Message, temp float64, schema map[string]any) (string, error)
+	// CompleteTools lets the model call tools. The answer either carries tool
+	// calls, whose results go back in tool messages, or the final content.
+	CompleteTools(ctx context.Context, messages []Message, temp float64, tools []Tool) (Message, error)
+	// Stream passes every piece of the answer to onChunk as it arrives and
+	// returns the whole answer. Cancelling ctx aborts the generation.
+	Stream(ctx context.Context, messages []Message, temp float64, onChunk func(string) error) (string, error)
+	Name() string
+}
+
+// Providers holds the provider of every task the service performs.
+type Providers struct {
+	Categorize Provider
+	Chat       Provider
+	Advice     Provider
+	Parse      Provider
+}
+
+// LoadProviders reads the provider of each task from <TASK>_PROVIDER,
+// <TASK>_MODEL, <TASK>_URL and <TASK>_API_KEY, falling back to LLM_PROVIDER,
+// MODEL_NAME, the provider's default URL and LLM_API_KEY.
+func LoadProviders() (Providers, error) {
+	var p Providers
+	var err error
+	if p.Categorize, err = loadProvider("CATEGORIZE"); err != nil {
+		return Providers{}, err
+	}
+	if p.Chat, err = loadProvider("CHAT"); err != nil {
+		return Providers{}, err
+	}
+	if p.Advice, err = loadProvider("ADVICE"); err != nil {
+		return Providers{}, err
+	}
+	if p.Parse, err = loadProvider("PARSE"); err != nil {
+		return Providers{}, err
+	}
+	return p, nil
+}
+
+func loadProvider(task string) (Provider, error) {
+	kind := strings.ToLower(getEnv(task+"_PROVIDER", getEnv("LLM_PROVIDER", ProviderOllama)))
+	model := getEnv(task+"_MODEL", ModelName)
+	apiKey := getEnv(task+"_API_KEY", getEnv("LLM_API_KEY", ""))
+	switch kind {
+	case ProviderOllama:
+		return newOllamaProvider(getEnv(task+"_URL", OllamaURL), model), nil
+	case ProviderOpenAI:
+		return newOpenAIProvider(getEnv(task+"_URL", "https://api.openai.com/v1/chat/completions"), model, apiKey), nil
+	case ProviderLlamaCpp:
+		return newLlamaCppProvider(getEnv(task+"_URL", "http://llama-cpp:8080/v1/chat/completions"), model, apiKey), nil
+	}
+	return nil, fmt.Errorf("unknown provider %q for %s", kind, strings.ToLower(task))
+}
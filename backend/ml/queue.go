@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Priorities of the tasks. A free worker goes to the waiting request with the
+// lowest priority value, the oldest one first.
+const (
+	PriorityCategorize = iota
+	PriorityChat
+	PriorityAdvice
+)
+
+// queueRetryAfter is the Retry-After, in seconds, of a request refused
+// because its queue is full.
+const queueRetryAfter = 5
+
+// ErrQueueFull refuses a request at once instead of letting it wait behind a
+// queue that is already at its limit.
+var ErrQueueFull = errors.New("model queue is full")
+
+// WorkerPool bounds the model calls running at the same time. Calls beyond
+// that wait in the queue of their task.
+type WorkerPool struct {
+	mu      sync.Mutex
+	workers int
+	busy    int
+	seq     uint64
+	queues  []*taskQueue
+}
+
+type taskQueue struct {
+	task     string
+	priority int
+	limit    int
+	waiting  []*waiter
+
+	running  int
+	started  int64
+	finished int64
+	rejected int64
+	canceled int64
+	waited   time.Duration
+}
+
+type waiter struct {
+	seq   uint64
+	since time.Time
+	ready chan struct{}
+}
+
+func NewWorkerPool(workers int) *WorkerPool {
+	return &WorkerPool{workers: workers}
+}
+
+// Queue puts every provider behind the pool. The queue of a task holds at
+// most <TASK>_QUEUE_LIMIT waiting calls.
+func (p *WorkerPool) Queue(providers Providers) Providers {
+	return Providers{
+		Categorize: p.queued(providers.Categorize, "categorize", PriorityCategorize, getEnvInt("CATEGORIZE_QUEUE_LIMIT", 100)),
+		Chat:       p.queued(providers.Chat, "chat", PriorityChat, getEnvInt("CHAT_QUEUE_LIMIT", 20)),
+		Advice:     p.queued(providers.Advice, "advice", PriorityAdvice, getEnvInt("ADVICE_QUEUE_LIMIT", 5)),
+		// Parsing is interactive and short, so it waits with the chat.
+		Parse: p.queued(providers.Parse, "parse", PriorityChat, getEnvInt("PARSE_QUEUE_LIMIT", 20)),
+	}
+}
+
+func (p *WorkerPool) queued(provider Provider, task string, priority, limit int) Provider {
+	q := &taskQueue{task: task, priority: priority, limit: limit}
+	p.queues = append(p.queues, q)
+	return &queuedProvider{Provider: provider, pool: p, queue: q}
+}
+
+// acquire waits for a worker for a call of q. It fails with ErrQueueFull when
+// q is at its limit and with the context error when ctx ends first.
+func (p *WorkerPool) acquire(ctx context.Context, q *taskQueue) (func(), error) {
+	p.mu.Lock()
+	if p.busy < p.workers {
+		p.busy++
+		q.running++
+		q.started++
+		p.mu.Unlock()
+		return func() { p.release(q) }, nil
+	}
+	if len(q.waiting) >= q.limit {
+		q.rejected++
+		p.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	p.seq++
+	w := &waiter{seq: p.seq, since: time.Now(), ready: make(chan struct{})}
+	q.waiting = append(q.waiting, w)
+	p.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return func() { p.release(q) }, nil
+	case <-ctx.Done():
+	}
+	p.mu.Lock()
+	if i := slices.Index(q.waiting, w); i >= 0 {
+		q.waiting = slices.Delete(q.waiting, i, i+1)
+		q.canceled++
+		p.mu.Unlock()
+		return nil, ctx.Err()
+	}
+	p.mu.Unlock()
+	// The worker was handed over just as ctx ended, so the call has started;
+	// finish it to pass the worker on.
+	p.release(q)
+	return nil, ctx.Err()
+}
+
+func (p *WorkerPool) release(q *taskQueue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	q.running--
+	q.finished++
+	p.handOff()
+}
+
+// handOff gives the worker of a finished call to the next waiting one, or
+// frees it.
+func (p *WorkerPool) handOff() {
+	var next *taskQueue
+	for _, q := range p.queues {
+		if len(q.waiting) == 0 {
+			continue
+		}
+		if next == nil || q.priority < next.priority || (q.priority == next.priority && q.waiting[0].seq < next.waiting[0].seq) {
+			next = q
+		}
+	}
+	if next == nil {
+		p.busy--
+		return
+	}
+	w := next.waiting[0]
+	next.waiting = next.waiting[1:]
+	next.running++
+	next.started++
+	next.waited += time.Since(w.since)
+	close(w.ready)
+}
+
+func (p *WorkerPool) Stats() QueueStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := QueueStats{Workers: p.workers, Busy: p.busy, Queues: make([]TaskQueueStats, len(p.queues))}
+	for i, q := range p.queues {
+		s := TaskQueueStats{
+			Task:     q.task,
+			Priority: q.priority,
+			Limit:    q.limit,
+			Waiting:  len(q.waiting),
+			Running:  q.running,
+			Started:  q.started,
+			Finished: q.finished,
+			Rejected: q.rejected,
+			Canceled: q.canceled,
+		}
+		if q.started > 0 {
+			s.AvgWaitMs = q.waited.Milliseconds() / q.started
+		}
+		stats.Queues[i] = s
+	}
+	return stats
+}
+
+// queuedProvider holds a worker for every call, a whole stream included.
+type queuedProvider struct {
+	Provider
+	pool  *WorkerPool
+	queue *taskQueue
+}
+
+func (p *queuedProvider) Complete(ctx context.Context, messages []Message, temp float64) (string, error) {
+	release, err := p.pool.acquire(ctx, p.queue)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return p.Provider.Complete(ctx, messages, temp)
+}
+
+func (p *queuedProvider) CompleteJSON(ctx context.Context, messages []Message, temp float64, schema map[string]any) (string, error) {
+	release, err := p.pool.acquire(ctx, p.queue)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return p.Provider.CompleteJSON(ctx, messages, temp, schema)
+}
+
+func (p *queuedProvider) CompleteTools(ctx context.Context, messages []Message, temp float64, tools []Tool) (Message, error) {
+	release, err := p.pool.acquire(ctx, p.queue)
+	if err != nil {
+		return Message{}, err
+	}
+	defer release()
+	return p.Provider.CompleteTools(ctx, messages, temp, tools)
+}
+
+func (p *queuedProvider) Stream(ctx context.Context, messages []Message, temp float64, onChunk func(string) error) (string, error) {
+	release, err := p.pool.acquire(ctx, p.queue)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return p.Provider.Stream(ctx, messages, temp, onChunk)
+}
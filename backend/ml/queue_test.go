@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestPool returns a pool of one worker with the queues of the service,
+// each holding at most limit waiting calls.
+func newTestPool(limit int) (p *WorkerPool, categorize, chat, advice *taskQueue) {
+	p = NewWorkerPool(1)
+	p.queued(nil, "categorize", PriorityCategorize, limit)
+	p.queued(nil, "chat", PriorityChat, limit)
+	p.queued(nil, "advice", PriorityAdvice, limit)
+	return p, p.queues[0], p.queues[1], p.queues[2]
+}
+
+// waitForQueue waits until q has n waiting calls.
+func waitForQueue(t *testing.T, p *WorkerPool, q *taskQueue, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		waiting := queueStats(p, q).Waiting
+		if waiting == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s queue has %d waiting calls, want %d", q.task, waiting, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func queueStats(p *WorkerPool, q *taskQueue) TaskQueueStats {
+	for _, s := range p.Stats().Queues {
+		if s.Task == q.task {
+			return s
+		}
+	}
+	return TaskQueueStats{}
+}
+
+func TestWorkerPoolPriority(t *testing.T) {
+	ctx := context.Background()
+	p, categorize, chat, advice := newTestPool(10)
+	release, err := p.acquire(ctx, chat)
+	if err != nil {
+		t.Fatalf("acquire a free worker: %v", err)
+	}
+
+	order := make(chan string, 4)
+	enqueue := func(name string, q *taskQueue) {
+		waiting := queueStats(p, q).Waiting
+		go func() {
+			release, err := p.acquire(ctx, q)
+			if err != nil {
+				order <- "error: " + err.Error()
+				return
+			}
+			order <- name
+			release()
+		}()
+		waitForQueue(t, p, q, waiting+1)
+	}
+	enqueue("advice", advice)
+	enqueue("first chat", chat)
+	enqueue("categorize", categorize)
+	enqueue("second chat", chat)
+	release()
+
+	want := []string{"categorize", "first chat", "second chat", "advice"}
+	for i, name := range want {
+		select {
+		case got := <-order:
+			if got != name {
+				t.Fatalf("call %d was %q, want %q", i+1, got, name)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("call %d (%q) did not start", i+1, name)
+		}
+	}
+	if busy := p.Stats().Busy; busy != 0 {
+		t.Fatalf("%d workers busy after all calls finished", busy)
+	}
+}
+
+func TestWorkerPoolQueueFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p, _, chat, advice := newTestPool(1)
+	release, err := p.acquire(ctx, chat)
+	if err != nil {
+		t.Fatalf("acquire a free worker: %v", err)
+	}
+	defer release()
+
+	go p.acquire(ctx, chat)
+	waitForQueue(t, p, chat, 1)
+	if _, err := p.acquire(ctx, chat); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("acquire with a full queue: got %v, want ErrQueueFull", err)
+	}
+	if s := queueStats(p, chat); s.Rejected != 1 || s.Waiting != 1 {
+		t.Fatalf("chat queue %+v, want one rejected and one waiting call", s)
+	}
+
+	// The limit is per queue.
+	go p.acquire(ctx, advice)
+	waitForQueue(t, p, advice, 1)
+}
+
+func TestWorkerPoolCancelWhileQueued(t *testing.T) {
+	p, _, chat, _ := newTestPool(10)
+	release, err := p.acquire(context.Background(), chat)
+	if err != nil {
+		t.Fatalf("acquire a free worker: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.acquire(ctx, chat)
+		done <- err
+	}()
+	waitForQueue(t, p, chat, 1)
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("acquire after cancel: got %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("acquire did not return after cancel")
+	}
+
+	release()
+	s := queueStats(p, chat)
+	if s.Waiting != 0 || s.Canceled != 1 || s.Started != 1 || s.Finished != 1 {
+		t.Fatalf("chat queue %+v, want the canceled call counted only as canceled", s)
+	}
+	if busy := p.Stats().Busy; busy != 0 {
+		t.Fatalf("%d workers busy, want the worker freed", busy)
+	}
+}
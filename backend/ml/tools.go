@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// maxToolRounds bounds the tool calls of one chat answer; after the last
+	// round the model has to answer with what it has.
+	maxToolRounds = 5
+	toolTimeout   = 30 * time.Second
+)
+
+const toolsSystemPrompt = `
+Today is %s. You can call tools that read the user's real transactions, spending and budgets. When a question is about the user's own finances, call the tools instead of guessing and base the answer only on their results. If the tools return no data, say so.`
+
+// ToolClient calls the chat tools the manager serves on its internal port.
+type ToolClient struct {
+	url    string
+	secret string
+	client *http.Client
+
+	mu    sync.Mutex
+	tools []Tool
+}
+
+func NewToolClient(url, secret string) *ToolClient {
+	return &ToolClient{url: url, secret: secret, client: &http.Client{Timeout: toolTimeout}}
+}
+
+// Tools fetches the tool descriptions once; a failed fetch is retried on the
+// next chat.
+func (t *ToolClient) Tools(ctx context.Context) ([]Tool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tools != nil {
+		return t.tools, nil
+	}
+	var tools []Tool
+	if err := t.do(ctx, http.MethodGet, "/tools", nil, &tools); err != nil {
+		return nil, err
+	}
+	t.tools = tools
+	return tools, nil
+}
+
+// Call runs a tool for the user. Errors are handed to the model as the
+// result, so it can fix the arguments or tell the user.
+func (t *ToolClient) Call(ctx context.Context, uid int64, call ToolCall) string {
+	body := map[string]any{"user_id": uid, "name": call.Function.Name, "arguments": call.Function.Arguments}
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := t.do(ctx, http.MethodPost, "/tools/call", body, &resp); err != nil {
+		errResult, _ := json.Marshal(map[string]string{"error": err.Error()})
+		return string(errResult)
+	}
+	return string(resp.Result)
+}
+
+func (t *ToolClient) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal tools request: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonData)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, t.url+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create tools request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if t.secret != "" {
+		req.Header.Set("X-Tools-Secret", t.secret)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call tools: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("tools status %d: %s", resp.StatusCode, string(respBody))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode tools response: %w", err)
+	}
+	return nil
+}
+
+func toolSpecs(tools []Tool) []ToolSpec {
+	specs := make([]ToolSpec, len(tools))
+	for i, tool := range tools {
+		specs[i] = ToolSpec{Type: "function", Function: tool}
+	}
+	return specs
+}
+
+// answerWithTools lets the provider call tools for up to maxToolRounds rounds
+// and returns its final answer. The tool messages are only kept for this
+// answer and never reach the chat history.
+func answerWithTools(ctx context.Context, provider Provider, uid int64, messages []Message, tools []Tool, temp float64) (string, error) {
+	messages, answer, answered, err := toolRounds(ctx, provider, uid, messages, tools, temp)
+	if err != nil || answered {
+		return answer, err
+	}
+	return provider.Complete(ctx, messages, temp)
+}
+
+// streamWithTools is answerWithTools for a streamed answer. The tool rounds
+// are not streamed; the final answer is then generated by Stream over their
+// results, so it reaches the user as it is written. An answer the model gave
+// in a tool round is dropped for it.
+func streamWithTools(ctx context.Context, provider Provider, uid int64, messages []Message, tools []Tool, temp float64, onChunk func(string) error) (string, error) {
+	messages, _, _, err := toolRounds(ctx, provider, uid, messages, tools, temp)
+	if err != nil {
+		return "", err
+	}
+	return provider.Stream(ctx, messages, temp, onChunk)
+}
+
+// toolRounds runs the tool calls of the model for up to maxToolRounds rounds.
+// It returns the messages with the calls and their results and, when the
+// model stopped calling tools, its answer. A model that rejects tools in the
+// first round gets the plain messages back, to answer without them.
+func toolRounds(ctx context.Context, provider Provider, uid int64, messages []Message, tools []Tool, temp float64) ([]Message, string, bool, error) {
+	plain := messages
+	messages = append([]Message{}, messages...)
+	messages[0].Content += fmt.Sprintf(toolsSystemPrompt, time.Now().Format("2006-01-02"))
+	for round := range maxToolRounds {
+		answer, err := provider.CompleteTools(ctx, messages, temp, tools)
+		if err != nil && round == 0 && ctx.Err() == nil && !errors.Is(err, ErrQueueFull) {
+			log.Printf("%s failed with tools, answering without them: %v", provider.Name(), err)
+			return plain, "", false, nil
+		}
+		if err != nil {
+			return nil, "", false, err
+		}
+		if len(answer.ToolCalls) == 0 {
+			return messages, answer.Content, true, nil
+		}
+		messages = append(messages, Message{Role: "assistant", Content: answer.Content, ToolCalls: answer.ToolCalls})
+		for _, call := range answer.ToolCalls {
+			messages = append(messages, Message{
+				Role:       "tool",
+				Content:    toolClient.Call(ctx, uid, call),
+				ToolCallID: call.ID,
+				ToolName:   call.Function.Name,
+			})
+		}
+	}
+	return messages, "", false, nil
+}
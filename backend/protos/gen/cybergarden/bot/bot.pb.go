@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: cybergarden/bot/bot.proto
+
+package cyberbott
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthData      string                 `protobuf:"bytes,1,opt,name=AuthData,proto3" json:"AuthData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthRequest) Reset() {
+	*x = AuthRequest{}
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRequest) ProtoMessage() {}
+
+func (x *AuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_bot_bot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthRequest) GetAuthData() string {
+	if x != nil {
+		return x.AuthData
+	}
+	return ""
+}
+
+type AuthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authorized    bool                   `protobuf:"varint,1,opt,name=Authorized,proto3" json:"Authorized,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_bot_bot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthResponse) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *AuthResponse) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AuthResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_bot_bot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendMessageRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_bot_bot_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_bot_bot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendMessageResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_cybergarden_bot_bot_proto protoreflect.FileDescriptor
+
+const file_cybergarden_bot_bot_proto_rawDesc = "" +
+	"\n" +
+	"\x19cybergarden/bot/bot.proto\x12\bcyberbot\")\n" +
+	"\vAuthRequest\x12\x1a\n" +
+	"\bAuthData\x18\x01 \x01(\tR\bAuthData\"\\\n" +
+	"\fAuthResponse\x12\x1e\n" +
+	"\n" +
+	"Authorized\x18\x01 \x01(\bR\n" +
+	"Authorized\x12\x16\n" +
+	"\x06UserID\x18\x02 \x01(\x03R\x06UserID\x12\x14\n" +
+	"\x05Error\x18\x03 \x01(\tR\x05Error\"F\n" +
+	"\x12SendMessageRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x18\n" +
+	"\aMessage\x18\x02 \x01(\tR\aMessage\"+\n" +
+	"\x13SendMessageResponse\x12\x14\n" +
+	"\x05Error\x18\x01 \x01(\tR\x05Error2\x88\x01\n" +
+	"\x03Bot\x125\n" +
+	"\x04Auth\x12\x15.cyberbot.AuthRequest\x1a\x16.cyberbot.AuthResponse\x12J\n" +
+	"\vSendMessage\x12\x1c.cyberbot.SendMessageRequest\x1a\x1d.cyberbot.SendMessageResponseB\x1eZ\x1csirius.cyberbot.v1;cyberbottb\x06proto3"
+
+var (
+	file_cybergarden_bot_bot_proto_rawDescOnce sync.Once
+	file_cybergarden_bot_bot_proto_rawDescData []byte
+)
+
+func file_cybergarden_bot_bot_proto_rawDescGZIP() []byte {
+	file_cybergarden_bot_bot_proto_rawDescOnce.Do(func() {
+		file_cybergarden_bot_bot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cybergarden_bot_bot_proto_rawDesc), len(file_cybergarden_bot_bot_proto_rawDesc)))
+	})
+	return file_cybergarden_bot_bot_proto_rawDescData
+}
+
+var file_cybergarden_bot_bot_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cybergarden_bot_bot_proto_goTypes = []any{
+	(*AuthRequest)(nil),         // 0: cyberbot.AuthRequest
+	(*AuthResponse)(nil),        // 1: cyberbot.AuthResponse
+	(*SendMessageRequest)(nil),  // 2: cyberbot.SendMessageRequest
+	(*SendMessageResponse)(nil), // 3: cyberbot.SendMessageResponse
+}
+var file_cybergarden_bot_bot_proto_depIdxs = []int32{
+	0, // 0: cyberbot.Bot.Auth:input_type -> cyberbot.AuthRequest
+	2, // 1: cyberbot.Bot.SendMessage:input_type -> cyberbot.SendMessageRequest
+	1, // 2: cyberbot.Bot.Auth:output_type -> cyberbot.AuthResponse
+	3, // 3: cyberbot.Bot.SendMessage:output_type -> cyberbot.SendMessageResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cybergarden_bot_bot_proto_init() }
+func file_cybergarden_bot_bot_proto_init() {
+	if File_cybergarden_bot_bot_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cybergarden_bot_bot_proto_rawDesc), len(file_cybergarden_bot_bot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cybergarden_bot_bot_proto_goTypes,
+		DependencyIndexes: file_cybergarden_bot_bot_proto_depIdxs,
+		MessageInfos:      file_cybergarden_bot_bot_proto_msgTypes,
+	}.Build()
+	File_cybergarden_bot_bot_proto = out.File
+	file_cybergarden_bot_bot_proto_goTypes = nil
+	file_cybergarden_bot_bot_proto_depIdxs = nil
+}
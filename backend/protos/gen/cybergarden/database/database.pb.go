@@ -0,0 +1,6901 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: cybergarden/database/database.proto
+
+package cyberdatabasee
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserRequest) Reset() {
+	*x = AddUserRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserRequest) ProtoMessage() {}
+
+func (x *AddUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserRequest.ProtoReflect.Descriptor instead.
+func (*AddUserRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddUserRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type AddUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserResponse) Reset() {
+	*x = AddUserResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserResponse) ProtoMessage() {}
+
+func (x *AddUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserResponse.ProtoReflect.Descriptor instead.
+func (*AddUserResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddUserResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteUserRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteUserResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type AddTransactionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserID      int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Transaction *Transaction           `protobuf:"bytes,2,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	// Categorize stores the transaction with a pending category and queues a
+	// categorization job for it.
+	Categorize    bool `protobuf:"varint,3,opt,name=Categorize,proto3" json:"Categorize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionRequest) Reset() {
+	*x = AddTransactionRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionRequest) ProtoMessage() {}
+
+func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTransactionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddTransactionRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *AddTransactionRequest) GetCategorize() bool {
+	if x != nil {
+		return x.Categorize
+	}
+	return false
+}
+
+type AddTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionResponse) Reset() {
+	*x = AddTransactionResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionResponse) ProtoMessage() {}
+
+func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddTransactionResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+func (x *AddTransactionResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type AddTransactionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Transactions   []*Transaction         `protobuf:"bytes,2,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+	SkipDuplicates bool                   `protobuf:"varint,3,opt,name=SkipDuplicates,proto3" json:"SkipDuplicates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddTransactionsRequest) Reset() {
+	*x = AddTransactionsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionsRequest) ProtoMessage() {}
+
+func (x *AddTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddTransactionsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddTransactionsRequest) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *AddTransactionsRequest) GetSkipDuplicates() bool {
+	if x != nil {
+		return x.SkipDuplicates
+	}
+	return false
+}
+
+type AddTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IDs           []int64                `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionsResponse) Reset() {
+	*x = AddTransactionsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionsResponse) ProtoMessage() {}
+
+func (x *AddTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddTransactionsResponse) GetIDs() []int64 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+func (x *AddTransactionsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type EditTransactionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserID      int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Transaction *Transaction           `protobuf:"bytes,2,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	// Recategorize marks a category change made by the categoriser: only the
+	// category is written, only while it still is PreviousKategoria, and it
+	// is not recorded as a correction.
+	Recategorize      bool   `protobuf:"varint,3,opt,name=Recategorize,proto3" json:"Recategorize,omitempty"`
+	PreviousKategoria string `protobuf:"bytes,4,opt,name=PreviousKategoria,proto3" json:"PreviousKategoria,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EditTransactionRequest) Reset() {
+	*x = EditTransactionRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditTransactionRequest) ProtoMessage() {}
+
+func (x *EditTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditTransactionRequest.ProtoReflect.Descriptor instead.
+func (*EditTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EditTransactionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EditTransactionRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *EditTransactionRequest) GetRecategorize() bool {
+	if x != nil {
+		return x.Recategorize
+	}
+	return false
+}
+
+func (x *EditTransactionRequest) GetPreviousKategoria() string {
+	if x != nil {
+		return x.PreviousKategoria
+	}
+	return ""
+}
+
+type EditTransactionResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes          string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	CategoryCorrected bool                   `protobuf:"varint,2,opt,name=CategoryCorrected,proto3" json:"CategoryCorrected,omitempty"`
+	Applied           bool                   `protobuf:"varint,3,opt,name=Applied,proto3" json:"Applied,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EditTransactionResponse) Reset() {
+	*x = EditTransactionResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditTransactionResponse) ProtoMessage() {}
+
+func (x *EditTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditTransactionResponse.ProtoReflect.Descriptor instead.
+func (*EditTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EditTransactionResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+func (x *EditTransactionResponse) GetCategoryCorrected() bool {
+	if x != nil {
+		return x.CategoryCorrected
+	}
+	return false
+}
+
+func (x *EditTransactionResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type DeleteTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTransactionRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteTransactionRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteTransactionResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Filter        *TransactionFilter     `protobuf:"bytes,2,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	SortBy        string                 `protobuf:"bytes,3,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,6,opt,name=BaseCurrency,proto3" json:"BaseCurrency,omitempty"`
+	RateDate      int64                  `protobuf:"varint,7,opt,name=RateDate,proto3" json:"RateDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserTransactionsRequest) Reset() {
+	*x = RequestUserTransactionsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserTransactionsRequest) ProtoMessage() {}
+
+func (x *RequestUserTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestUserTransactionsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestUserTransactionsRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *RequestUserTransactionsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *RequestUserTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RequestUserTransactionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RequestUserTransactionsRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *RequestUserTransactionsRequest) GetRateDate() int64 {
+	if x != nil {
+		return x.RateDate
+	}
+	return 0
+}
+
+type RequestUserTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=Total,proto3" json:"Total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserTransactionsResponse) Reset() {
+	*x = RequestUserTransactionsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserTransactionsResponse) ProtoMessage() {}
+
+func (x *RequestUserTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequestUserTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *RequestUserTransactionsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+func (x *RequestUserTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *RequestUserTransactionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TransactionFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom      int64                  `protobuf:"varint,1,opt,name=DateFrom,proto3" json:"DateFrom,omitempty"`
+	DateTo        int64                  `protobuf:"varint,2,opt,name=DateTo,proto3" json:"DateTo,omitempty"`
+	Kategorias    []string               `protobuf:"bytes,3,rep,name=Kategorias,proto3" json:"Kategorias,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	AmountMin     *int64                 `protobuf:"varint,5,opt,name=AmountMin,proto3,oneof" json:"AmountMin,omitempty"`
+	AmountMax     *int64                 `protobuf:"varint,6,opt,name=AmountMax,proto3,oneof" json:"AmountMax,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=Description,proto3" json:"Description,omitempty"`
+	AccountID     int64                  `protobuf:"varint,8,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionFilter) Reset() {
+	*x = TransactionFilter{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFilter) ProtoMessage() {}
+
+func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionFilter) GetDateFrom() int64 {
+	if x != nil {
+		return x.DateFrom
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetDateTo() int64 {
+	if x != nil {
+		return x.DateTo
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetKategorias() []string {
+	if x != nil {
+		return x.Kategorias
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetAmountMin() int64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetAmountMax() int64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *TransactionFilter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetAccountID() int64 {
+	if x != nil {
+		return x.AccountID
+	}
+	return 0
+}
+
+type Transaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ID              int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Date            int64                  `protobuf:"varint,2,opt,name=Date,proto3" json:"Date,omitempty"`
+	Kategoria       string                 `protobuf:"bytes,3,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount          int64                  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	AccountID       int64                  `protobuf:"varint,7,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	TransferID      int64                  `protobuf:"varint,8,opt,name=TransferID,proto3" json:"TransferID,omitempty"`
+	Currency        string                 `protobuf:"bytes,9,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	BaseAmount      *int64                 `protobuf:"varint,10,opt,name=BaseAmount,proto3,oneof" json:"BaseAmount,omitempty"`
+	CategoryPending bool                   `protobuf:"varint,11,opt,name=CategoryPending,proto3" json:"CategoryPending,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Transaction) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Transaction) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *Transaction) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+func (x *Transaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetAccountID() int64 {
+	if x != nil {
+		return x.AccountID
+	}
+	return 0
+}
+
+func (x *Transaction) GetTransferID() int64 {
+	if x != nil {
+		return x.TransferID
+	}
+	return 0
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetBaseAmount() int64 {
+	if x != nil && x.BaseAmount != nil {
+		return *x.BaseAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetCategoryPending() bool {
+	if x != nil {
+		return x.CategoryPending
+	}
+	return false
+}
+
+type SetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Budget        *Budget                `protobuf:"bytes,2,opt,name=Budget,proto3" json:"Budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetRequest) Reset() {
+	*x = SetBudgetRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetRequest) ProtoMessage() {}
+
+func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetBudgetRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SetBudgetRequest) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type SetBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetResponse) Reset() {
+	*x = SetBudgetResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetResponse) ProtoMessage() {}
+
+func (x *SetBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetBudgetResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SetBudgetResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteBudgetRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteBudgetRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteBudgetResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PeriodStart   int64                  `protobuf:"varint,2,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,3,opt,name=PeriodEnd,proto3" json:"PeriodEnd,omitempty"`
+	Kategorias    []string               `protobuf:"bytes,4,rep,name=Kategorias,proto3" json:"Kategorias,omitempty"`
+	TransactionID int64                  `protobuf:"varint,5,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserBudgetsRequest) Reset() {
+	*x = RequestUserBudgetsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserBudgetsRequest) ProtoMessage() {}
+
+func (x *RequestUserBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RequestUserBudgetsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestUserBudgetsRequest) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *RequestUserBudgetsRequest) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *RequestUserBudgetsRequest) GetKategorias() []string {
+	if x != nil {
+		return x.Kategorias
+	}
+	return nil
+}
+
+func (x *RequestUserBudgetsRequest) GetTransactionID() int64 {
+	if x != nil {
+		return x.TransactionID
+	}
+	return 0
+}
+
+type RequestUserBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=Budgets,proto3" json:"Budgets,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserBudgetsResponse) Reset() {
+	*x = RequestUserBudgetsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserBudgetsResponse) ProtoMessage() {}
+
+func (x *RequestUserBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequestUserBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *RequestUserBudgetsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Kategoria     string                 `protobuf:"bytes,2,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Spent         int64                  `protobuf:"varint,4,opt,name=Spent,proto3" json:"Spent,omitempty"`
+	Added         int64                  `protobuf:"varint,5,opt,name=Added,proto3" json:"Added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Budget) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Budget) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+func (x *Budget) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Budget) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *Budget) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type AddRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Recurring     *Recurring             `protobuf:"bytes,2,opt,name=Recurring,proto3" json:"Recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRecurringRequest) Reset() {
+	*x = AddRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecurringRequest) ProtoMessage() {}
+
+func (x *AddRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecurringRequest.ProtoReflect.Descriptor instead.
+func (*AddRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddRecurringRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddRecurringRequest) GetRecurring() *Recurring {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type AddRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRecurringResponse) Reset() {
+	*x = AddRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecurringResponse) ProtoMessage() {}
+
+func (x *AddRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecurringResponse.ProtoReflect.Descriptor instead.
+func (*AddRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddRecurringResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AddRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserRecurringRequest) Reset() {
+	*x = RequestUserRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserRecurringRequest) ProtoMessage() {}
+
+func (x *RequestUserRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserRecurringRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RequestUserRecurringRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RequestUserRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     []*Recurring           `protobuf:"bytes,1,rep,name=Recurring,proto3" json:"Recurring,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserRecurringResponse) Reset() {
+	*x = RequestUserRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserRecurringResponse) ProtoMessage() {}
+
+func (x *RequestUserRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserRecurringResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RequestUserRecurringResponse) GetRecurring() []*Recurring {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+func (x *RequestUserRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type SetRecurringPausedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=Paused,proto3" json:"Paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecurringPausedRequest) Reset() {
+	*x = SetRecurringPausedRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecurringPausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecurringPausedRequest) ProtoMessage() {}
+
+func (x *SetRecurringPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecurringPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetRecurringPausedRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetRecurringPausedRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SetRecurringPausedRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *SetRecurringPausedRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type SetRecurringPausedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecurringPausedResponse) Reset() {
+	*x = SetRecurringPausedResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecurringPausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecurringPausedResponse) ProtoMessage() {}
+
+func (x *SetRecurringPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecurringPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetRecurringPausedResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetRecurringPausedResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringRequest) Reset() {
+	*x = DeleteRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteRecurringRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteRecurringRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringResponse) Reset() {
+	*x = DeleteRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringResponse) ProtoMessage() {}
+
+func (x *DeleteRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestDueRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        int64                  `protobuf:"varint,1,opt,name=Before,proto3" json:"Before,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDueRecurringRequest) Reset() {
+	*x = RequestDueRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDueRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDueRecurringRequest) ProtoMessage() {}
+
+func (x *RequestDueRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDueRecurringRequest.ProtoReflect.Descriptor instead.
+func (*RequestDueRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestDueRecurringRequest) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *RequestDueRecurringRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RequestDueRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     []*Recurring           `protobuf:"bytes,1,rep,name=Recurring,proto3" json:"Recurring,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDueRecurringResponse) Reset() {
+	*x = RequestDueRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDueRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDueRecurringResponse) ProtoMessage() {}
+
+func (x *RequestDueRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDueRecurringResponse.ProtoReflect.Descriptor instead.
+func (*RequestDueRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequestDueRecurringResponse) GetRecurring() []*Recurring {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+func (x *RequestDueRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type AdvanceRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Runs          int64                  `protobuf:"varint,2,opt,name=Runs,proto3" json:"Runs,omitempty"`
+	NextRuns      int64                  `protobuf:"varint,3,opt,name=NextRuns,proto3" json:"NextRuns,omitempty"`
+	NextDate      int64                  `protobuf:"varint,4,opt,name=NextDate,proto3" json:"NextDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceRecurringRequest) Reset() {
+	*x = AdvanceRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceRecurringRequest) ProtoMessage() {}
+
+func (x *AdvanceRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceRecurringRequest.ProtoReflect.Descriptor instead.
+func (*AdvanceRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdvanceRecurringRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AdvanceRecurringRequest) GetRuns() int64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *AdvanceRecurringRequest) GetNextRuns() int64 {
+	if x != nil {
+		return x.NextRuns
+	}
+	return 0
+}
+
+func (x *AdvanceRecurringRequest) GetNextDate() int64 {
+	if x != nil {
+		return x.NextDate
+	}
+	return 0
+}
+
+type AdvanceRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claimed       bool                   `protobuf:"varint,1,opt,name=Claimed,proto3" json:"Claimed,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceRecurringResponse) Reset() {
+	*x = AdvanceRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceRecurringResponse) ProtoMessage() {}
+
+func (x *AdvanceRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceRecurringResponse.ProtoReflect.Descriptor instead.
+func (*AdvanceRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AdvanceRecurringResponse) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *AdvanceRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type MaterializeRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Runs          int64                  `protobuf:"varint,3,opt,name=Runs,proto3" json:"Runs,omitempty"`
+	NextDate      int64                  `protobuf:"varint,4,opt,name=NextDate,proto3" json:"NextDate,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,5,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterializeRecurringRequest) Reset() {
+	*x = MaterializeRecurringRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterializeRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeRecurringRequest) ProtoMessage() {}
+
+func (x *MaterializeRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeRecurringRequest.ProtoReflect.Descriptor instead.
+func (*MaterializeRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MaterializeRecurringRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *MaterializeRecurringRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *MaterializeRecurringRequest) GetRuns() int64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *MaterializeRecurringRequest) GetNextDate() int64 {
+	if x != nil {
+		return x.NextDate
+	}
+	return 0
+}
+
+func (x *MaterializeRecurringRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type MaterializeRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claimed       bool                   `protobuf:"varint,1,opt,name=Claimed,proto3" json:"Claimed,omitempty"`
+	TransactionID int64                  `protobuf:"varint,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,3,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterializeRecurringResponse) Reset() {
+	*x = MaterializeRecurringResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterializeRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterializeRecurringResponse) ProtoMessage() {}
+
+func (x *MaterializeRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterializeRecurringResponse.ProtoReflect.Descriptor instead.
+func (*MaterializeRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MaterializeRecurringResponse) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *MaterializeRecurringResponse) GetTransactionID() int64 {
+	if x != nil {
+		return x.TransactionID
+	}
+	return 0
+}
+
+func (x *MaterializeRecurringResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Recurring struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Kategoria     string                 `protobuf:"bytes,3,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	Frequency     string                 `protobuf:"bytes,7,opt,name=Frequency,proto3" json:"Frequency,omitempty"`
+	StartDate     int64                  `protobuf:"varint,8,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	EndDate       int64                  `protobuf:"varint,9,opt,name=EndDate,proto3" json:"EndDate,omitempty"`
+	NextDate      int64                  `protobuf:"varint,10,opt,name=NextDate,proto3" json:"NextDate,omitempty"`
+	Runs          int64                  `protobuf:"varint,11,opt,name=Runs,proto3" json:"Runs,omitempty"`
+	Paused        bool                   `protobuf:"varint,12,opt,name=Paused,proto3" json:"Paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurring) Reset() {
+	*x = Recurring{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurring) ProtoMessage() {}
+
+func (x *Recurring) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurring.ProtoReflect.Descriptor instead.
+func (*Recurring) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Recurring) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Recurring) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Recurring) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+func (x *Recurring) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Recurring) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Recurring) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Recurring) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *Recurring) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *Recurring) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+func (x *Recurring) GetNextDate() int64 {
+	if x != nil {
+		return x.NextDate
+	}
+	return 0
+}
+
+func (x *Recurring) GetRuns() int64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *Recurring) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type RequestUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	DateFrom      int64                  `protobuf:"varint,2,opt,name=DateFrom,proto3" json:"DateFrom,omitempty"`
+	DateTo        int64                  `protobuf:"varint,3,opt,name=DateTo,proto3" json:"DateTo,omitempty"`
+	Granularity   string                 `protobuf:"bytes,4,opt,name=Granularity,proto3" json:"Granularity,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
+	TopN          int64                  `protobuf:"varint,6,opt,name=TopN,proto3" json:"TopN,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,7,opt,name=BaseCurrency,proto3" json:"BaseCurrency,omitempty"`
+	RateDate      int64                  `protobuf:"varint,8,opt,name=RateDate,proto3" json:"RateDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserStatsRequest) Reset() {
+	*x = RequestUserStatsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserStatsRequest) ProtoMessage() {}
+
+func (x *RequestUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestUserStatsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestUserStatsRequest) GetDateFrom() int64 {
+	if x != nil {
+		return x.DateFrom
+	}
+	return 0
+}
+
+func (x *RequestUserStatsRequest) GetDateTo() int64 {
+	if x != nil {
+		return x.DateTo
+	}
+	return 0
+}
+
+func (x *RequestUserStatsRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *RequestUserStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RequestUserStatsRequest) GetTopN() int64 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *RequestUserStatsRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *RequestUserStatsRequest) GetRateDate() int64 {
+	if x != nil {
+		return x.RateDate
+	}
+	return 0
+}
+
+type RequestUserStatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Income          int64                  `protobuf:"varint,1,opt,name=Income,proto3" json:"Income,omitempty"`
+	Expense         int64                  `protobuf:"varint,2,opt,name=Expense,proto3" json:"Expense,omitempty"`
+	Net             int64                  `protobuf:"varint,3,opt,name=Net,proto3" json:"Net,omitempty"`
+	Categories      []*CategoryTotal       `protobuf:"bytes,4,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	Periods         []*PeriodTotal         `protobuf:"bytes,5,rep,name=Periods,proto3" json:"Periods,omitempty"`
+	TopDescriptions []*DescriptionTotal    `protobuf:"bytes,6,rep,name=TopDescriptions,proto3" json:"TopDescriptions,omitempty"`
+	ErrorMes        string                 `protobuf:"bytes,7,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestUserStatsResponse) Reset() {
+	*x = RequestUserStatsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserStatsResponse) ProtoMessage() {}
+
+func (x *RequestUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RequestUserStatsResponse) GetIncome() int64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *RequestUserStatsResponse) GetExpense() int64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+func (x *RequestUserStatsResponse) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *RequestUserStatsResponse) GetCategories() []*CategoryTotal {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *RequestUserStatsResponse) GetPeriods() []*PeriodTotal {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *RequestUserStatsResponse) GetTopDescriptions() []*DescriptionTotal {
+	if x != nil {
+		return x.TopDescriptions
+	}
+	return nil
+}
+
+func (x *RequestUserStatsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type CategoryTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kategoria     string                 `protobuf:"bytes,1,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTotal) Reset() {
+	*x = CategoryTotal{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTotal) ProtoMessage() {}
+
+func (x *CategoryTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTotal.ProtoReflect.Descriptor instead.
+func (*CategoryTotal) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CategoryTotal) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+func (x *CategoryTotal) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CategoryTotal) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CategoryTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PeriodTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   int64                  `protobuf:"varint,1,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
+	Income        int64                  `protobuf:"varint,2,opt,name=Income,proto3" json:"Income,omitempty"`
+	Expense       int64                  `protobuf:"varint,3,opt,name=Expense,proto3" json:"Expense,omitempty"`
+	Net           int64                  `protobuf:"varint,4,opt,name=Net,proto3" json:"Net,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodTotal) Reset() {
+	*x = PeriodTotal{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodTotal) ProtoMessage() {}
+
+func (x *PeriodTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodTotal.ProtoReflect.Descriptor instead.
+func (*PeriodTotal) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PeriodTotal) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *PeriodTotal) GetIncome() int64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *PeriodTotal) GetExpense() int64 {
+	if x != nil {
+		return x.Expense
+	}
+	return 0
+}
+
+func (x *PeriodTotal) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+type DescriptionTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescriptionTotal) Reset() {
+	*x = DescriptionTotal{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescriptionTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionTotal) ProtoMessage() {}
+
+func (x *DescriptionTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionTotal.ProtoReflect.Descriptor instead.
+func (*DescriptionTotal) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DescriptionTotal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DescriptionTotal) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DescriptionTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AddAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountRequest) Reset() {
+	*x = AddAccountRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountRequest) ProtoMessage() {}
+
+func (x *AddAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountRequest.ProtoReflect.Descriptor instead.
+func (*AddAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AddAccountRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AddAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountResponse) Reset() {
+	*x = AddAccountResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountResponse) ProtoMessage() {}
+
+func (x *AddAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountResponse.ProtoReflect.Descriptor instead.
+func (*AddAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AddAccountResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AddAccountResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type EditAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditAccountRequest) Reset() {
+	*x = EditAccountRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAccountRequest) ProtoMessage() {}
+
+func (x *EditAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAccountRequest.ProtoReflect.Descriptor instead.
+func (*EditAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EditAccountRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EditAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type EditAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditAccountResponse) Reset() {
+	*x = EditAccountResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAccountResponse) ProtoMessage() {}
+
+func (x *EditAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAccountResponse.ProtoReflect.Descriptor instead.
+func (*EditAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EditAccountResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteAccountRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteAccountRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteAccountResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserAccountsRequest) Reset() {
+	*x = RequestUserAccountsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserAccountsRequest) ProtoMessage() {}
+
+func (x *RequestUserAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserAccountsRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RequestUserAccountsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RequestUserAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserAccountsResponse) Reset() {
+	*x = RequestUserAccountsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserAccountsResponse) ProtoMessage() {}
+
+func (x *RequestUserAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserAccountsResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RequestUserAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *RequestUserAccountsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Account struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ID             int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	OpeningBalance int64                  `protobuf:"varint,4,opt,name=OpeningBalance,proto3" json:"OpeningBalance,omitempty"`
+	Balance        int64                  `protobuf:"varint,5,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	IsDefault      bool                   `protobuf:"varint,6,opt,name=IsDefault,proto3" json:"IsDefault,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Account) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetOpeningBalance() int64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type TransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Transfer      *Transfer              `protobuf:"bytes,2,opt,name=Transfer,proto3" json:"Transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TransferRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type TransferResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransferID        int64                  `protobuf:"varint,1,opt,name=TransferID,proto3" json:"TransferID,omitempty"`
+	FromTransactionID int64                  `protobuf:"varint,2,opt,name=FromTransactionID,proto3" json:"FromTransactionID,omitempty"`
+	ToTransactionID   int64                  `protobuf:"varint,3,opt,name=ToTransactionID,proto3" json:"ToTransactionID,omitempty"`
+	ErrorMes          string                 `protobuf:"bytes,4,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TransferResponse) GetTransferID() int64 {
+	if x != nil {
+		return x.TransferID
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetFromTransactionID() int64 {
+	if x != nil {
+		return x.FromTransactionID
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetToTransactionID() int64 {
+	if x != nil {
+		return x.ToTransactionID
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountID int64                  `protobuf:"varint,1,opt,name=FromAccountID,proto3" json:"FromAccountID,omitempty"`
+	ToAccountID   int64                  `protobuf:"varint,2,opt,name=ToAccountID,proto3" json:"ToAccountID,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	ToAmount      int64                  `protobuf:"varint,4,opt,name=ToAmount,proto3" json:"ToAmount,omitempty"`
+	Date          int64                  `protobuf:"varint,5,opt,name=Date,proto3" json:"Date,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Transfer) GetFromAccountID() int64 {
+	if x != nil {
+		return x.FromAccountID
+	}
+	return 0
+}
+
+func (x *Transfer) GetToAccountID() int64 {
+	if x != nil {
+		return x.ToAccountID
+	}
+	return 0
+}
+
+func (x *Transfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transfer) GetToAmount() int64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *Transfer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type LoadExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadExchangeRatesRequest) Reset() {
+	*x = LoadExchangeRatesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadExchangeRatesRequest) ProtoMessage() {}
+
+func (x *LoadExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*LoadExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LoadExchangeRatesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LoadExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loaded        int64                  `protobuf:"varint,1,opt,name=Loaded,proto3" json:"Loaded,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadExchangeRatesResponse) Reset() {
+	*x = LoadExchangeRatesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadExchangeRatesResponse) ProtoMessage() {}
+
+func (x *LoadExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*LoadExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LoadExchangeRatesResponse) GetLoaded() int64 {
+	if x != nil {
+		return x.Loaded
+	}
+	return 0
+}
+
+func (x *LoadExchangeRatesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type AddChatMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Messages      []*ChatMessage         `protobuf:"bytes,2,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChatMessagesRequest) Reset() {
+	*x = AddChatMessagesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChatMessagesRequest) ProtoMessage() {}
+
+func (x *AddChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*AddChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddChatMessagesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddChatMessagesRequest) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type AddChatMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChatMessagesResponse) Reset() {
+	*x = AddChatMessagesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChatMessagesResponse) ProtoMessage() {}
+
+func (x *AddChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*AddChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddChatMessagesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestChatMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Before        int64                  `protobuf:"varint,3,opt,name=Before,proto3" json:"Before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestChatMessagesRequest) Reset() {
+	*x = RequestChatMessagesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestChatMessagesRequest) ProtoMessage() {}
+
+func (x *RequestChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*RequestChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RequestChatMessagesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestChatMessagesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RequestChatMessagesRequest) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+type RequestChatMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestChatMessagesResponse) Reset() {
+	*x = RequestChatMessagesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestChatMessagesResponse) ProtoMessage() {}
+
+func (x *RequestChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*RequestChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RequestChatMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *RequestChatMessagesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type ClearChatMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearChatMessagesRequest) Reset() {
+	*x = ClearChatMessagesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChatMessagesRequest) ProtoMessage() {}
+
+func (x *ClearChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ClearChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ClearChatMessagesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ClearChatMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearChatMessagesResponse) Reset() {
+	*x = ClearChatMessagesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearChatMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChatMessagesResponse) ProtoMessage() {}
+
+func (x *ClearChatMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChatMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ClearChatMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ClearChatMessagesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChatMessage) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AddCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=Category,proto3" json:"Category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryRequest) Reset() {
+	*x = AddCategoryRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryRequest) ProtoMessage() {}
+
+func (x *AddCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryRequest.ProtoReflect.Descriptor instead.
+func (*AddCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddCategoryRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type AddCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCategoryResponse) Reset() {
+	*x = AddCategoryResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryResponse) ProtoMessage() {}
+
+func (x *AddCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryResponse.ProtoReflect.Descriptor instead.
+func (*AddCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddCategoryResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AddCategoryResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type EditCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=Category,proto3" json:"Category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryRequest) Reset() {
+	*x = EditCategoryRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryRequest) ProtoMessage() {}
+
+func (x *EditCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryRequest.ProtoReflect.Descriptor instead.
+func (*EditCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EditCategoryRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EditCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type EditCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryResponse) Reset() {
+	*x = EditCategoryResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryResponse) ProtoMessage() {}
+
+func (x *EditCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryResponse.ProtoReflect.Descriptor instead.
+func (*EditCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EditCategoryResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteCategoryRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteCategoryResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserCategoriesRequest) Reset() {
+	*x = RequestUserCategoriesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserCategoriesRequest) ProtoMessage() {}
+
+func (x *RequestUserCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RequestUserCategoriesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RequestUserCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserCategoriesResponse) Reset() {
+	*x = RequestUserCategoriesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserCategoriesResponse) ProtoMessage() {}
+
+func (x *RequestUserCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RequestUserCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *RequestUserCategoriesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=Icon,proto3" json:"Icon,omitempty"`
+	Color         string                 `protobuf:"bytes,4,opt,name=Color,proto3" json:"Color,omitempty"`
+	ParentID      int64                  `protobuf:"varint,5,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=Type,proto3" json:"Type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Category) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Category) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Category) GetParentID() int64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+func (x *Category) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type AddRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Rule          *Rule                  `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRuleRequest) Reset() {
+	*x = AddRuleRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRuleRequest) ProtoMessage() {}
+
+func (x *AddRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddRuleRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type AddRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRuleResponse) Reset() {
+	*x = AddRuleResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRuleResponse) ProtoMessage() {}
+
+func (x *AddRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddRuleResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AddRuleResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type EditRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Rule          *Rule                  `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditRuleRequest) Reset() {
+	*x = EditRuleRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditRuleRequest) ProtoMessage() {}
+
+func (x *EditRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditRuleRequest.ProtoReflect.Descriptor instead.
+func (*EditRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EditRuleRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *EditRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type EditRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditRuleResponse) Reset() {
+	*x = EditRuleResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditRuleResponse) ProtoMessage() {}
+
+func (x *EditRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditRuleResponse.ProtoReflect.Descriptor instead.
+func (*EditRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EditRuleResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteRuleRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteRuleRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleResponse) Reset() {
+	*x = DeleteRuleResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleResponse) ProtoMessage() {}
+
+func (x *DeleteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteRuleResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestUserRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserRulesRequest) Reset() {
+	*x = RequestUserRulesRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserRulesRequest) ProtoMessage() {}
+
+func (x *RequestUserRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserRulesRequest.ProtoReflect.Descriptor instead.
+func (*RequestUserRulesRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RequestUserRulesRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RequestUserRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestUserRulesResponse) Reset() {
+	*x = RequestUserRulesResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestUserRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserRulesResponse) ProtoMessage() {}
+
+func (x *RequestUserRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserRulesResponse.ProtoReflect.Descriptor instead.
+func (*RequestUserRulesResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RequestUserRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RequestUserRulesResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type Rule struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ID                  int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Priority            int64                  `protobuf:"varint,2,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	DescriptionContains string                 `protobuf:"bytes,3,opt,name=DescriptionContains,proto3" json:"DescriptionContains,omitempty"`
+	DescriptionRegex    string                 `protobuf:"bytes,4,opt,name=DescriptionRegex,proto3" json:"DescriptionRegex,omitempty"`
+	AmountMin           int64                  `protobuf:"varint,5,opt,name=AmountMin,proto3" json:"AmountMin,omitempty"`
+	AmountMax           int64                  `protobuf:"varint,6,opt,name=AmountMax,proto3" json:"AmountMax,omitempty"`
+	Type                string                 `protobuf:"bytes,7,opt,name=Type,proto3" json:"Type,omitempty"`
+	Kategoria           string                 `protobuf:"bytes,8,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	Global              bool                   `protobuf:"varint,9,opt,name=Global,proto3" json:"Global,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Rule) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Rule) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Rule) GetDescriptionContains() string {
+	if x != nil {
+		return x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *Rule) GetDescriptionRegex() string {
+	if x != nil {
+		return x.DescriptionRegex
+	}
+	return ""
+}
+
+func (x *Rule) GetAmountMin() int64 {
+	if x != nil {
+		return x.AmountMin
+	}
+	return 0
+}
+
+func (x *Rule) GetAmountMax() int64 {
+	if x != nil {
+		return x.AmountMax
+	}
+	return 0
+}
+
+func (x *Rule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Rule) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+func (x *Rule) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+type RequestCategoryCorrectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCategoryCorrectionsRequest) Reset() {
+	*x = RequestCategoryCorrectionsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCategoryCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCategoryCorrectionsRequest) ProtoMessage() {}
+
+func (x *RequestCategoryCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCategoryCorrectionsRequest.ProtoReflect.Descriptor instead.
+func (*RequestCategoryCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RequestCategoryCorrectionsRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestCategoryCorrectionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RequestCategoryCorrectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Corrections   []*CategoryCorrection  `protobuf:"bytes,1,rep,name=Corrections,proto3" json:"Corrections,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCategoryCorrectionsResponse) Reset() {
+	*x = RequestCategoryCorrectionsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCategoryCorrectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCategoryCorrectionsResponse) ProtoMessage() {}
+
+func (x *RequestCategoryCorrectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCategoryCorrectionsResponse.ProtoReflect.Descriptor instead.
+func (*RequestCategoryCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RequestCategoryCorrectionsResponse) GetCorrections() []*CategoryCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *RequestCategoryCorrectionsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type CategoryCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TransactionID int64                  `protobuf:"varint,2,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	OldKategoria  string                 `protobuf:"bytes,6,opt,name=OldKategoria,proto3" json:"OldKategoria,omitempty"`
+	NewKategoria  string                 `protobuf:"bytes,7,opt,name=NewKategoria,proto3" json:"NewKategoria,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryCorrection) Reset() {
+	*x = CategoryCorrection{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryCorrection) ProtoMessage() {}
+
+func (x *CategoryCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryCorrection.ProtoReflect.Descriptor instead.
+func (*CategoryCorrection) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CategoryCorrection) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CategoryCorrection) GetTransactionID() int64 {
+	if x != nil {
+		return x.TransactionID
+	}
+	return 0
+}
+
+func (x *CategoryCorrection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategoryCorrection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CategoryCorrection) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CategoryCorrection) GetOldKategoria() string {
+	if x != nil {
+		return x.OldKategoria
+	}
+	return ""
+}
+
+func (x *CategoryCorrection) GetNewKategoria() string {
+	if x != nil {
+		return x.NewKategoria
+	}
+	return ""
+}
+
+func (x *CategoryCorrection) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ClaimCategorizationJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Now           int64                  `protobuf:"varint,1,opt,name=Now,proto3" json:"Now,omitempty"`
+	LeaseUntil    int64                  `protobuf:"varint,2,opt,name=LeaseUntil,proto3" json:"LeaseUntil,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCategorizationJobsRequest) Reset() {
+	*x = ClaimCategorizationJobsRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCategorizationJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCategorizationJobsRequest) ProtoMessage() {}
+
+func (x *ClaimCategorizationJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCategorizationJobsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimCategorizationJobsRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ClaimCategorizationJobsRequest) GetNow() int64 {
+	if x != nil {
+		return x.Now
+	}
+	return 0
+}
+
+func (x *ClaimCategorizationJobsRequest) GetLeaseUntil() int64 {
+	if x != nil {
+		return x.LeaseUntil
+	}
+	return 0
+}
+
+func (x *ClaimCategorizationJobsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ClaimCategorizationJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*CategorizationJob   `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCategorizationJobsResponse) Reset() {
+	*x = ClaimCategorizationJobsResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCategorizationJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCategorizationJobsResponse) ProtoMessage() {}
+
+func (x *ClaimCategorizationJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCategorizationJobsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimCategorizationJobsResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ClaimCategorizationJobsResponse) GetJobs() []*CategorizationJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ClaimCategorizationJobsResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type CategorizationJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	TransactionID int64                  `protobuf:"varint,3,opt,name=TransactionID,proto3" json:"TransactionID,omitempty"`
+	Attempts      int64                  `protobuf:"varint,4,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,5,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategorizationJob) Reset() {
+	*x = CategorizationJob{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategorizationJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategorizationJob) ProtoMessage() {}
+
+func (x *CategorizationJob) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategorizationJob.ProtoReflect.Descriptor instead.
+func (*CategorizationJob) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CategorizationJob) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CategorizationJob) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *CategorizationJob) GetTransactionID() int64 {
+	if x != nil {
+		return x.TransactionID
+	}
+	return 0
+}
+
+func (x *CategorizationJob) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *CategorizationJob) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type CompleteCategorizationJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Attempts      int64                  `protobuf:"varint,2,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	Kategoria     string                 `protobuf:"bytes,3,opt,name=Kategoria,proto3" json:"Kategoria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteCategorizationJobRequest) Reset() {
+	*x = CompleteCategorizationJobRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteCategorizationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCategorizationJobRequest) ProtoMessage() {}
+
+func (x *CompleteCategorizationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCategorizationJobRequest.ProtoReflect.Descriptor instead.
+func (*CompleteCategorizationJobRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CompleteCategorizationJobRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CompleteCategorizationJobRequest) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *CompleteCategorizationJobRequest) GetKategoria() string {
+	if x != nil {
+		return x.Kategoria
+	}
+	return ""
+}
+
+type CompleteCategorizationJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=Applied,proto3" json:"Applied,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteCategorizationJobResponse) Reset() {
+	*x = CompleteCategorizationJobResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteCategorizationJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCategorizationJobResponse) ProtoMessage() {}
+
+func (x *CompleteCategorizationJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCategorizationJobResponse.ProtoReflect.Descriptor instead.
+func (*CompleteCategorizationJobResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CompleteCategorizationJobResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *CompleteCategorizationJobResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type FailCategorizationJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Attempts      int64                  `protobuf:"varint,2,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	RetryAt       int64                  `protobuf:"varint,4,opt,name=RetryAt,proto3" json:"RetryAt,omitempty"`
+	Final         bool                   `protobuf:"varint,5,opt,name=Final,proto3" json:"Final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailCategorizationJobRequest) Reset() {
+	*x = FailCategorizationJobRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailCategorizationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailCategorizationJobRequest) ProtoMessage() {}
+
+func (x *FailCategorizationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailCategorizationJobRequest.ProtoReflect.Descriptor instead.
+func (*FailCategorizationJobRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FailCategorizationJobRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FailCategorizationJobRequest) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *FailCategorizationJobRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FailCategorizationJobRequest) GetRetryAt() int64 {
+	if x != nil {
+		return x.RetryAt
+	}
+	return 0
+}
+
+func (x *FailCategorizationJobRequest) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+type FailCategorizationJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMes      string                 `protobuf:"bytes,1,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailCategorizationJobResponse) Reset() {
+	*x = FailCategorizationJobResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailCategorizationJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailCategorizationJobResponse) ProtoMessage() {}
+
+func (x *FailCategorizationJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailCategorizationJobResponse.ProtoReflect.Descriptor instead.
+func (*FailCategorizationJobResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FailCategorizationJobResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RateLimitState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        float64                `protobuf:"fixed64,1,opt,name=Tokens,proto3" json:"Tokens,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,2,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Day           int64                  `protobuf:"varint,3,opt,name=Day,proto3" json:"Day,omitempty"`
+	Used          int64                  `protobuf:"varint,4,opt,name=Used,proto3" json:"Used,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitState) Reset() {
+	*x = RateLimitState{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitState) ProtoMessage() {}
+
+func (x *RateLimitState) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitState.ProtoReflect.Descriptor instead.
+func (*RateLimitState) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RateLimitState) GetTokens() float64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *RateLimitState) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RequestRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRateLimitRequest) Reset() {
+	*x = RequestRateLimitRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRateLimitRequest) ProtoMessage() {}
+
+func (x *RequestRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*RequestRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RequestRateLimitRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RequestRateLimitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RequestRateLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *RateLimitState        `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRateLimitResponse) Reset() {
+	*x = RequestRateLimitResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRateLimitResponse) ProtoMessage() {}
+
+func (x *RequestRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*RequestRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RequestRateLimitResponse) GetState() *RateLimitState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *RequestRateLimitResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type SwapRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	State         *RateLimitState        `protobuf:"bytes,3,opt,name=State,proto3" json:"State,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapRateLimitRequest) Reset() {
+	*x = SwapRateLimitRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRateLimitRequest) ProtoMessage() {}
+
+func (x *SwapRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*SwapRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SwapRateLimitRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SwapRateLimitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SwapRateLimitRequest) GetState() *RateLimitState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type SwapRateLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swapped       bool                   `protobuf:"varint,1,opt,name=Swapped,proto3" json:"Swapped,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapRateLimitResponse) Reset() {
+	*x = SwapRateLimitResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRateLimitResponse) ProtoMessage() {}
+
+func (x *SwapRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*SwapRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SwapRateLimitResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+func (x *SwapRateLimitResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type StartRecategorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Now           int64                  `protobuf:"varint,4,opt,name=Now,proto3" json:"Now,omitempty"`
+	StaleBefore   int64                  `protobuf:"varint,5,opt,name=StaleBefore,proto3" json:"StaleBefore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRecategorizationRequest) Reset() {
+	*x = StartRecategorizationRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecategorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecategorizationRequest) ProtoMessage() {}
+
+func (x *StartRecategorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecategorizationRequest.ProtoReflect.Descriptor instead.
+func (*StartRecategorizationRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *StartRecategorizationRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *StartRecategorizationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartRecategorizationRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StartRecategorizationRequest) GetNow() int64 {
+	if x != nil {
+		return x.Now
+	}
+	return 0
+}
+
+func (x *StartRecategorizationRequest) GetStaleBefore() int64 {
+	if x != nil {
+		return x.StaleBefore
+	}
+	return 0
+}
+
+type StartRecategorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Started       bool                   `protobuf:"varint,2,opt,name=Started,proto3" json:"Started,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,3,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRecategorizationResponse) Reset() {
+	*x = StartRecategorizationResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecategorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecategorizationResponse) ProtoMessage() {}
+
+func (x *StartRecategorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecategorizationResponse.ProtoReflect.Descriptor instead.
+func (*StartRecategorizationResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StartRecategorizationResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *StartRecategorizationResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *StartRecategorizationResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type UpdateRecategorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID            int64                  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=FromStatus,proto3" json:"FromStatus,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=Data,proto3" json:"Data,omitempty"`
+	Now           int64                  `protobuf:"varint,6,opt,name=Now,proto3" json:"Now,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecategorizationRequest) Reset() {
+	*x = UpdateRecategorizationRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecategorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecategorizationRequest) ProtoMessage() {}
+
+func (x *UpdateRecategorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecategorizationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecategorizationRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateRecategorizationRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateRecategorizationRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateRecategorizationRequest) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *UpdateRecategorizationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateRecategorizationRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateRecategorizationRequest) GetNow() int64 {
+	if x != nil {
+		return x.Now
+	}
+	return 0
+}
+
+type UpdateRecategorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=Updated,proto3" json:"Updated,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,2,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecategorizationResponse) Reset() {
+	*x = UpdateRecategorizationResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecategorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecategorizationResponse) ProtoMessage() {}
+
+func (x *UpdateRecategorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecategorizationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRecategorizationResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateRecategorizationResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *UpdateRecategorizationResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+type RequestRecategorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRecategorizationRequest) Reset() {
+	*x = RequestRecategorizationRequest{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRecategorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRecategorizationRequest) ProtoMessage() {}
+
+func (x *RequestRecategorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRecategorizationRequest.ProtoReflect.Descriptor instead.
+func (*RequestRecategorizationRequest) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RequestRecategorizationRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RequestRecategorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Found         bool                   `protobuf:"varint,4,opt,name=Found,proto3" json:"Found,omitempty"`
+	ErrorMes      string                 `protobuf:"bytes,5,opt,name=ErrorMes,proto3" json:"ErrorMes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRecategorizationResponse) Reset() {
+	*x = RequestRecategorizationResponse{}
+	mi := &file_cybergarden_database_database_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRecategorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRecategorizationResponse) ProtoMessage() {}
+
+func (x *RequestRecategorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cybergarden_database_database_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRecategorizationResponse.ProtoReflect.Descriptor instead.
+func (*RequestRecategorizationResponse) Descriptor() ([]byte, []int) {
+	return file_cybergarden_database_database_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RequestRecategorizationResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RequestRecategorizationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RequestRecategorizationResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RequestRecategorizationResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *RequestRecategorizationResponse) GetErrorMes() string {
+	if x != nil {
+		return x.ErrorMes
+	}
+	return ""
+}
+
+var File_cybergarden_database_database_proto protoreflect.FileDescriptor
+
+const file_cybergarden_database_database_proto_rawDesc = "" +
+	"\n" +
+	"#cybergarden/database/database.proto\x12\rcyberdatabase\"(\n" +
+	"\x0eAddUserRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"-\n" +
+	"\x0fAddUserResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"+\n" +
+	"\x11DeleteUserRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"0\n" +
+	"\x12DeleteUserResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"\x8d\x01\n" +
+	"\x15AddTransactionRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12<\n" +
+	"\vTransaction\x18\x02 \x01(\v2\x1a.cyberdatabase.TransactionR\vTransaction\x12\x1e\n" +
+	"\n" +
+	"Categorize\x18\x03 \x01(\bR\n" +
+	"Categorize\"D\n" +
+	"\x16AddTransactionResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"\x98\x01\n" +
+	"\x16AddTransactionsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12>\n" +
+	"\fTransactions\x18\x02 \x03(\v2\x1a.cyberdatabase.TransactionR\fTransactions\x12&\n" +
+	"\x0eSkipDuplicates\x18\x03 \x01(\bR\x0eSkipDuplicates\"G\n" +
+	"\x17AddTransactionsResponse\x12\x10\n" +
+	"\x03IDs\x18\x01 \x03(\x03R\x03IDs\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\xc0\x01\n" +
+	"\x16EditTransactionRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12<\n" +
+	"\vTransaction\x18\x02 \x01(\v2\x1a.cyberdatabase.TransactionR\vTransaction\x12\"\n" +
+	"\fRecategorize\x18\x03 \x01(\bR\fRecategorize\x12,\n" +
+	"\x11PreviousKategoria\x18\x04 \x01(\tR\x11PreviousKategoria\"}\n" +
+	"\x17EditTransactionResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\x12,\n" +
+	"\x11CategoryCorrected\x18\x02 \x01(\bR\x11CategoryCorrected\x12\x18\n" +
+	"\aApplied\x18\x03 \x01(\bR\aApplied\"B\n" +
+	"\x18DeleteTransactionRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"7\n" +
+	"\x19DeleteTransactionResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"\xf8\x01\n" +
+	"\x1eRequestUserTransactionsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x128\n" +
+	"\x06Filter\x18\x02 \x01(\v2 .cyberdatabase.TransactionFilterR\x06Filter\x12\x16\n" +
+	"\x06SortBy\x18\x03 \x01(\tR\x06SortBy\x12\x16\n" +
+	"\x06Cursor\x18\x04 \x01(\tR\x06Cursor\x12\x14\n" +
+	"\x05Limit\x18\x05 \x01(\x03R\x05Limit\x12\"\n" +
+	"\fBaseCurrency\x18\x06 \x01(\tR\fBaseCurrency\x12\x1a\n" +
+	"\bRateDate\x18\a \x01(\x03R\bRateDate\"\xb3\x01\n" +
+	"\x1fRequestUserTransactionsResponse\x12>\n" +
+	"\fTransactions\x18\x01 \x03(\v2\x1a.cyberdatabase.TransactionR\fTransactions\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\x03 \x01(\tR\n" +
+	"NextCursor\x12\x14\n" +
+	"\x05Total\x18\x04 \x01(\x03R\x05Total\"\x9d\x02\n" +
+	"\x11TransactionFilter\x12\x1a\n" +
+	"\bDateFrom\x18\x01 \x01(\x03R\bDateFrom\x12\x16\n" +
+	"\x06DateTo\x18\x02 \x01(\x03R\x06DateTo\x12\x1e\n" +
+	"\n" +
+	"Kategorias\x18\x03 \x03(\tR\n" +
+	"Kategorias\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12!\n" +
+	"\tAmountMin\x18\x05 \x01(\x03H\x00R\tAmountMin\x88\x01\x01\x12!\n" +
+	"\tAmountMax\x18\x06 \x01(\x03H\x01R\tAmountMax\x88\x01\x01\x12 \n" +
+	"\vDescription\x18\a \x01(\tR\vDescription\x12\x1c\n" +
+	"\tAccountID\x18\b \x01(\x03R\tAccountIDB\f\n" +
+	"\n" +
+	"_AmountMinB\f\n" +
+	"\n" +
+	"_AmountMax\"\xd5\x02\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Date\x18\x02 \x01(\x03R\x04Date\x12\x1c\n" +
+	"\tKategoria\x18\x03 \x01(\tR\tKategoria\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Amount\x18\x05 \x01(\x03R\x06Amount\x12 \n" +
+	"\vDescription\x18\x06 \x01(\tR\vDescription\x12\x1c\n" +
+	"\tAccountID\x18\a \x01(\x03R\tAccountID\x12\x1e\n" +
+	"\n" +
+	"TransferID\x18\b \x01(\x03R\n" +
+	"TransferID\x12\x1a\n" +
+	"\bCurrency\x18\t \x01(\tR\bCurrency\x12#\n" +
+	"\n" +
+	"BaseAmount\x18\n" +
+	" \x01(\x03H\x00R\n" +
+	"BaseAmount\x88\x01\x01\x12(\n" +
+	"\x0fCategoryPending\x18\v \x01(\bR\x0fCategoryPendingB\r\n" +
+	"\v_BaseAmount\"Y\n" +
+	"\x10SetBudgetRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12-\n" +
+	"\x06Budget\x18\x02 \x01(\v2\x15.cyberdatabase.BudgetR\x06Budget\"?\n" +
+	"\x11SetBudgetResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"=\n" +
+	"\x13DeleteBudgetRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"2\n" +
+	"\x14DeleteBudgetResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"\xb9\x01\n" +
+	"\x19RequestUserBudgetsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12 \n" +
+	"\vPeriodStart\x18\x02 \x01(\x03R\vPeriodStart\x12\x1c\n" +
+	"\tPeriodEnd\x18\x03 \x01(\x03R\tPeriodEnd\x12\x1e\n" +
+	"\n" +
+	"Kategorias\x18\x04 \x03(\tR\n" +
+	"Kategorias\x12$\n" +
+	"\rTransactionID\x18\x05 \x01(\x03R\rTransactionID\"i\n" +
+	"\x1aRequestUserBudgetsResponse\x12/\n" +
+	"\aBudgets\x18\x01 \x03(\v2\x15.cyberdatabase.BudgetR\aBudgets\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"x\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1c\n" +
+	"\tKategoria\x18\x02 \x01(\tR\tKategoria\x12\x14\n" +
+	"\x05Limit\x18\x03 \x01(\x03R\x05Limit\x12\x14\n" +
+	"\x05Spent\x18\x04 \x01(\x03R\x05Spent\x12\x14\n" +
+	"\x05Added\x18\x05 \x01(\x03R\x05Added\"e\n" +
+	"\x13AddRecurringRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x126\n" +
+	"\tRecurring\x18\x02 \x01(\v2\x18.cyberdatabase.RecurringR\tRecurring\"B\n" +
+	"\x14AddRecurringResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"5\n" +
+	"\x1bRequestUserRecurringRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"r\n" +
+	"\x1cRequestUserRecurringResponse\x126\n" +
+	"\tRecurring\x18\x01 \x03(\v2\x18.cyberdatabase.RecurringR\tRecurring\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"[\n" +
+	"\x19SetRecurringPausedRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x16\n" +
+	"\x06Paused\x18\x03 \x01(\bR\x06Paused\"8\n" +
+	"\x1aSetRecurringPausedResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"@\n" +
+	"\x16DeleteRecurringRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"5\n" +
+	"\x17DeleteRecurringResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"J\n" +
+	"\x1aRequestDueRecurringRequest\x12\x16\n" +
+	"\x06Before\x18\x01 \x01(\x03R\x06Before\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x03R\x05Limit\"q\n" +
+	"\x1bRequestDueRecurringResponse\x126\n" +
+	"\tRecurring\x18\x01 \x03(\v2\x18.cyberdatabase.RecurringR\tRecurring\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"u\n" +
+	"\x17AdvanceRecurringRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Runs\x18\x02 \x01(\x03R\x04Runs\x12\x1a\n" +
+	"\bNextRuns\x18\x03 \x01(\x03R\bNextRuns\x12\x1a\n" +
+	"\bNextDate\x18\x04 \x01(\x03R\bNextDate\"P\n" +
+	"\x18AdvanceRecurringResponse\x12\x18\n" +
+	"\aClaimed\x18\x01 \x01(\bR\aClaimed\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\xb3\x01\n" +
+	"\x1bMaterializeRecurringRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x16\n" +
+	"\x06UserID\x18\x02 \x01(\x03R\x06UserID\x12\x12\n" +
+	"\x04Runs\x18\x03 \x01(\x03R\x04Runs\x12\x1a\n" +
+	"\bNextDate\x18\x04 \x01(\x03R\bNextDate\x12<\n" +
+	"\vTransaction\x18\x05 \x01(\v2\x1a.cyberdatabase.TransactionR\vTransaction\"z\n" +
+	"\x1cMaterializeRecurringResponse\x12\x18\n" +
+	"\aClaimed\x18\x01 \x01(\bR\aClaimed\x12$\n" +
+	"\rTransactionID\x18\x02 \x01(\x03R\rTransactionID\x12\x1a\n" +
+	"\bErrorMes\x18\x03 \x01(\tR\bErrorMes\"\xbd\x02\n" +
+	"\tRecurring\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x16\n" +
+	"\x06UserID\x18\x02 \x01(\x03R\x06UserID\x12\x1c\n" +
+	"\tKategoria\x18\x03 \x01(\tR\tKategoria\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Amount\x18\x05 \x01(\x03R\x06Amount\x12 \n" +
+	"\vDescription\x18\x06 \x01(\tR\vDescription\x12\x1c\n" +
+	"\tFrequency\x18\a \x01(\tR\tFrequency\x12\x1c\n" +
+	"\tStartDate\x18\b \x01(\x03R\tStartDate\x12\x18\n" +
+	"\aEndDate\x18\t \x01(\x03R\aEndDate\x12\x1a\n" +
+	"\bNextDate\x18\n" +
+	" \x01(\x03R\bNextDate\x12\x12\n" +
+	"\x04Runs\x18\v \x01(\x03R\x04Runs\x12\x16\n" +
+	"\x06Paused\x18\f \x01(\bR\x06Paused\"\xf7\x01\n" +
+	"\x17RequestUserStatsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x1a\n" +
+	"\bDateFrom\x18\x02 \x01(\x03R\bDateFrom\x12\x16\n" +
+	"\x06DateTo\x18\x03 \x01(\x03R\x06DateTo\x12 \n" +
+	"\vGranularity\x18\x04 \x01(\tR\vGranularity\x12\x1a\n" +
+	"\bTimezone\x18\x05 \x01(\tR\bTimezone\x12\x12\n" +
+	"\x04TopN\x18\x06 \x01(\x03R\x04TopN\x12\"\n" +
+	"\fBaseCurrency\x18\a \x01(\tR\fBaseCurrency\x12\x1a\n" +
+	"\bRateDate\x18\b \x01(\x03R\bRateDate\"\xb9\x02\n" +
+	"\x18RequestUserStatsResponse\x12\x16\n" +
+	"\x06Income\x18\x01 \x01(\x03R\x06Income\x12\x18\n" +
+	"\aExpense\x18\x02 \x01(\x03R\aExpense\x12\x10\n" +
+	"\x03Net\x18\x03 \x01(\x03R\x03Net\x12<\n" +
+	"\n" +
+	"Categories\x18\x04 \x03(\v2\x1c.cyberdatabase.CategoryTotalR\n" +
+	"Categories\x124\n" +
+	"\aPeriods\x18\x05 \x03(\v2\x1a.cyberdatabase.PeriodTotalR\aPeriods\x12I\n" +
+	"\x0fTopDescriptions\x18\x06 \x03(\v2\x1f.cyberdatabase.DescriptionTotalR\x0fTopDescriptions\x12\x1a\n" +
+	"\bErrorMes\x18\a \x01(\tR\bErrorMes\"o\n" +
+	"\rCategoryTotal\x12\x1c\n" +
+	"\tKategoria\x18\x01 \x01(\tR\tKategoria\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Amount\x18\x03 \x01(\x03R\x06Amount\x12\x14\n" +
+	"\x05Count\x18\x04 \x01(\x03R\x05Count\"s\n" +
+	"\vPeriodTotal\x12 \n" +
+	"\vPeriodStart\x18\x01 \x01(\x03R\vPeriodStart\x12\x16\n" +
+	"\x06Income\x18\x02 \x01(\x03R\x06Income\x12\x18\n" +
+	"\aExpense\x18\x03 \x01(\x03R\aExpense\x12\x10\n" +
+	"\x03Net\x18\x04 \x01(\x03R\x03Net\"b\n" +
+	"\x10DescriptionTotal\x12 \n" +
+	"\vDescription\x18\x01 \x01(\tR\vDescription\x12\x16\n" +
+	"\x06Amount\x18\x02 \x01(\x03R\x06Amount\x12\x14\n" +
+	"\x05Count\x18\x03 \x01(\x03R\x05Count\"]\n" +
+	"\x11AddAccountRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x120\n" +
+	"\aAccount\x18\x02 \x01(\v2\x16.cyberdatabase.AccountR\aAccount\"@\n" +
+	"\x12AddAccountResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"^\n" +
+	"\x12EditAccountRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x120\n" +
+	"\aAccount\x18\x02 \x01(\v2\x16.cyberdatabase.AccountR\aAccount\"1\n" +
+	"\x13EditAccountResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\">\n" +
+	"\x14DeleteAccountRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"3\n" +
+	"\x15DeleteAccountResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"4\n" +
+	"\x1aRequestUserAccountsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"m\n" +
+	"\x1bRequestUserAccountsResponse\x122\n" +
+	"\bAccounts\x18\x01 \x03(\v2\x16.cyberdatabase.AccountR\bAccounts\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\xa9\x01\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1a\n" +
+	"\bCurrency\x18\x03 \x01(\tR\bCurrency\x12&\n" +
+	"\x0eOpeningBalance\x18\x04 \x01(\x03R\x0eOpeningBalance\x12\x18\n" +
+	"\aBalance\x18\x05 \x01(\x03R\aBalance\x12\x1c\n" +
+	"\tIsDefault\x18\x06 \x01(\bR\tIsDefault\"^\n" +
+	"\x0fTransferRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x123\n" +
+	"\bTransfer\x18\x02 \x01(\v2\x17.cyberdatabase.TransferR\bTransfer\"\xa6\x01\n" +
+	"\x10TransferResponse\x12\x1e\n" +
+	"\n" +
+	"TransferID\x18\x01 \x01(\x03R\n" +
+	"TransferID\x12,\n" +
+	"\x11FromTransactionID\x18\x02 \x01(\x03R\x11FromTransactionID\x12(\n" +
+	"\x0fToTransactionID\x18\x03 \x01(\x03R\x0fToTransactionID\x12\x1a\n" +
+	"\bErrorMes\x18\x04 \x01(\tR\bErrorMes\"\xbc\x01\n" +
+	"\bTransfer\x12$\n" +
+	"\rFromAccountID\x18\x01 \x01(\x03R\rFromAccountID\x12 \n" +
+	"\vToAccountID\x18\x02 \x01(\x03R\vToAccountID\x12\x16\n" +
+	"\x06Amount\x18\x03 \x01(\x03R\x06Amount\x12\x1a\n" +
+	"\bToAmount\x18\x04 \x01(\x03R\bToAmount\x12\x12\n" +
+	"\x04Date\x18\x05 \x01(\x03R\x04Date\x12 \n" +
+	"\vDescription\x18\x06 \x01(\tR\vDescription\".\n" +
+	"\x18LoadExchangeRatesRequest\x12\x12\n" +
+	"\x04Data\x18\x01 \x01(\fR\x04Data\"O\n" +
+	"\x19LoadExchangeRatesResponse\x12\x16\n" +
+	"\x06Loaded\x18\x01 \x01(\x03R\x06Loaded\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"h\n" +
+	"\x16AddChatMessagesRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x126\n" +
+	"\bMessages\x18\x02 \x03(\v2\x1a.cyberdatabase.ChatMessageR\bMessages\"5\n" +
+	"\x17AddChatMessagesResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"b\n" +
+	"\x1aRequestChatMessagesRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Before\x18\x03 \x01(\x03R\x06Before\"q\n" +
+	"\x1bRequestChatMessagesResponse\x126\n" +
+	"\bMessages\x18\x01 \x03(\v2\x1a.cyberdatabase.ChatMessageR\bMessages\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"2\n" +
+	"\x18ClearChatMessagesRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"7\n" +
+	"\x19ClearChatMessagesResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"i\n" +
+	"\vChatMessage\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Role\x18\x02 \x01(\tR\x04Role\x12\x18\n" +
+	"\aContent\x18\x03 \x01(\tR\aContent\x12\x1c\n" +
+	"\tCreatedAt\x18\x04 \x01(\x03R\tCreatedAt\"a\n" +
+	"\x12AddCategoryRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x123\n" +
+	"\bCategory\x18\x02 \x01(\v2\x17.cyberdatabase.CategoryR\bCategory\"A\n" +
+	"\x13AddCategoryResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"b\n" +
+	"\x13EditCategoryRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x123\n" +
+	"\bCategory\x18\x02 \x01(\v2\x17.cyberdatabase.CategoryR\bCategory\"2\n" +
+	"\x14EditCategoryResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"?\n" +
+	"\x15DeleteCategoryRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"4\n" +
+	"\x16DeleteCategoryResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"6\n" +
+	"\x1cRequestUserCategoriesRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"t\n" +
+	"\x1dRequestUserCategoriesResponse\x127\n" +
+	"\n" +
+	"Categories\x18\x01 \x03(\v2\x17.cyberdatabase.CategoryR\n" +
+	"Categories\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\x88\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x12\n" +
+	"\x04Icon\x18\x03 \x01(\tR\x04Icon\x12\x14\n" +
+	"\x05Color\x18\x04 \x01(\tR\x05Color\x12\x1a\n" +
+	"\bParentID\x18\x05 \x01(\x03R\bParentID\x12\x12\n" +
+	"\x04Type\x18\x06 \x01(\tR\x04Type\"Q\n" +
+	"\x0eAddRuleRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12'\n" +
+	"\x04Rule\x18\x02 \x01(\v2\x13.cyberdatabase.RuleR\x04Rule\"=\n" +
+	"\x0fAddRuleResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"R\n" +
+	"\x0fEditRuleRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12'\n" +
+	"\x04Rule\x18\x02 \x01(\v2\x13.cyberdatabase.RuleR\x04Rule\".\n" +
+	"\x10EditRuleResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\";\n" +
+	"\x11DeleteRuleRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\"0\n" +
+	"\x12DeleteRuleResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"1\n" +
+	"\x17RequestUserRulesRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"a\n" +
+	"\x18RequestUserRulesResponse\x12)\n" +
+	"\x05Rules\x18\x01 \x03(\v2\x13.cyberdatabase.RuleR\x05Rules\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\x96\x02\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bPriority\x18\x02 \x01(\x03R\bPriority\x120\n" +
+	"\x13DescriptionContains\x18\x03 \x01(\tR\x13DescriptionContains\x12*\n" +
+	"\x10DescriptionRegex\x18\x04 \x01(\tR\x10DescriptionRegex\x12\x1c\n" +
+	"\tAmountMin\x18\x05 \x01(\x03R\tAmountMin\x12\x1c\n" +
+	"\tAmountMax\x18\x06 \x01(\x03R\tAmountMax\x12\x12\n" +
+	"\x04Type\x18\a \x01(\tR\x04Type\x12\x1c\n" +
+	"\tKategoria\x18\b \x01(\tR\tKategoria\x12\x16\n" +
+	"\x06Global\x18\t \x01(\bR\x06Global\"Q\n" +
+	"!RequestCategoryCorrectionsRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x03R\x05Limit\"\x85\x01\n" +
+	"\"RequestCategoryCorrectionsResponse\x12C\n" +
+	"\vCorrections\x18\x01 \x03(\v2!.cyberdatabase.CategoryCorrectionR\vCorrections\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\xfe\x01\n" +
+	"\x12CategoryCorrection\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12$\n" +
+	"\rTransactionID\x18\x02 \x01(\x03R\rTransactionID\x12 \n" +
+	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Amount\x18\x05 \x01(\x03R\x06Amount\x12\"\n" +
+	"\fOldKategoria\x18\x06 \x01(\tR\fOldKategoria\x12\"\n" +
+	"\fNewKategoria\x18\a \x01(\tR\fNewKategoria\x12\x1c\n" +
+	"\tCreatedAt\x18\b \x01(\x03R\tCreatedAt\"h\n" +
+	"\x1eClaimCategorizationJobsRequest\x12\x10\n" +
+	"\x03Now\x18\x01 \x01(\x03R\x03Now\x12\x1e\n" +
+	"\n" +
+	"LeaseUntil\x18\x02 \x01(\x03R\n" +
+	"LeaseUntil\x12\x14\n" +
+	"\x05Limit\x18\x03 \x01(\x03R\x05Limit\"s\n" +
+	"\x1fClaimCategorizationJobsResponse\x124\n" +
+	"\x04Jobs\x18\x01 \x03(\v2 .cyberdatabase.CategorizationJobR\x04Jobs\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\xbb\x01\n" +
+	"\x11CategorizationJob\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x16\n" +
+	"\x06UserID\x18\x02 \x01(\x03R\x06UserID\x12$\n" +
+	"\rTransactionID\x18\x03 \x01(\x03R\rTransactionID\x12\x1a\n" +
+	"\bAttempts\x18\x04 \x01(\x03R\bAttempts\x12<\n" +
+	"\vTransaction\x18\x05 \x01(\v2\x1a.cyberdatabase.TransactionR\vTransaction\"l\n" +
+	" CompleteCategorizationJobRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bAttempts\x18\x02 \x01(\x03R\bAttempts\x12\x1c\n" +
+	"\tKategoria\x18\x03 \x01(\tR\tKategoria\"Y\n" +
+	"!CompleteCategorizationJobResponse\x12\x18\n" +
+	"\aApplied\x18\x01 \x01(\bR\aApplied\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\x90\x01\n" +
+	"\x1cFailCategorizationJobRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bAttempts\x18\x02 \x01(\x03R\bAttempts\x12\x14\n" +
+	"\x05Error\x18\x03 \x01(\tR\x05Error\x12\x18\n" +
+	"\aRetryAt\x18\x04 \x01(\x03R\aRetryAt\x12\x14\n" +
+	"\x05Final\x18\x05 \x01(\bR\x05Final\";\n" +
+	"\x1dFailCategorizationJobResponse\x12\x1a\n" +
+	"\bErrorMes\x18\x01 \x01(\tR\bErrorMes\"\x86\x01\n" +
+	"\x0eRateLimitState\x12\x16\n" +
+	"\x06Tokens\x18\x01 \x01(\x01R\x06Tokens\x12\x1c\n" +
+	"\tUpdatedAt\x18\x02 \x01(\x03R\tUpdatedAt\x12\x10\n" +
+	"\x03Day\x18\x03 \x01(\x03R\x03Day\x12\x12\n" +
+	"\x04Used\x18\x04 \x01(\x03R\x04Used\x12\x18\n" +
+	"\aVersion\x18\x05 \x01(\x03R\aVersion\"C\n" +
+	"\x17RequestRateLimitRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x10\n" +
+	"\x03Key\x18\x02 \x01(\tR\x03Key\"k\n" +
+	"\x18RequestRateLimitResponse\x123\n" +
+	"\x05State\x18\x01 \x01(\v2\x1d.cyberdatabase.RateLimitStateR\x05State\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"u\n" +
+	"\x14SwapRateLimitRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x10\n" +
+	"\x03Key\x18\x02 \x01(\tR\x03Key\x123\n" +
+	"\x05State\x18\x03 \x01(\v2\x1d.cyberdatabase.RateLimitStateR\x05State\"M\n" +
+	"\x15SwapRateLimitResponse\x12\x18\n" +
+	"\aSwapped\x18\x01 \x01(\bR\aSwapped\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"\x96\x01\n" +
+	"\x1cStartRecategorizationRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x10\n" +
+	"\x03Now\x18\x04 \x01(\x03R\x03Now\x12 \n" +
+	"\vStaleBefore\x18\x05 \x01(\x03R\vStaleBefore\"e\n" +
+	"\x1dStartRecategorizationResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x18\n" +
+	"\aStarted\x18\x02 \x01(\bR\aStarted\x12\x1a\n" +
+	"\bErrorMes\x18\x03 \x01(\tR\bErrorMes\"\xa5\x01\n" +
+	"\x1dUpdateRecategorizationRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\x12\x0e\n" +
+	"\x02ID\x18\x02 \x01(\x03R\x02ID\x12\x1e\n" +
+	"\n" +
+	"FromStatus\x18\x03 \x01(\tR\n" +
+	"FromStatus\x12\x16\n" +
+	"\x06Status\x18\x04 \x01(\tR\x06Status\x12\x12\n" +
+	"\x04Data\x18\x05 \x01(\fR\x04Data\x12\x10\n" +
+	"\x03Now\x18\x06 \x01(\x03R\x03Now\"V\n" +
+	"\x1eUpdateRecategorizationResponse\x12\x18\n" +
+	"\aUpdated\x18\x01 \x01(\bR\aUpdated\x12\x1a\n" +
+	"\bErrorMes\x18\x02 \x01(\tR\bErrorMes\"8\n" +
+	"\x1eRequestRecategorizationRequest\x12\x16\n" +
+	"\x06UserID\x18\x01 \x01(\x03R\x06UserID\"\x8f\x01\n" +
+	"\x1fRequestRecategorizationResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x14\n" +
+	"\x05Found\x18\x04 \x01(\bR\x05Found\x12\x1a\n" +
+	"\bErrorMes\x18\x05 \x01(\tR\bErrorMes2\xc9\"\n" +
+	"\bDatabase\x12H\n" +
+	"\aAddUser\x12\x1d.cyberdatabase.AddUserRequest\x1a\x1e.cyberdatabase.AddUserResponse\x12Q\n" +
+	"\n" +
+	"DeleteUser\x12 .cyberdatabase.DeleteUserRequest\x1a!.cyberdatabase.DeleteUserResponse\x12]\n" +
+	"\x0eAddTransaction\x12$.cyberdatabase.AddTransactionRequest\x1a%.cyberdatabase.AddTransactionResponse\x12`\n" +
+	"\x0fAddTransactions\x12%.cyberdatabase.AddTransactionsRequest\x1a&.cyberdatabase.AddTransactionsResponse\x12`\n" +
+	"\x0fEditTransaction\x12%.cyberdatabase.EditTransactionRequest\x1a&.cyberdatabase.EditTransactionResponse\x12f\n" +
+	"\x11DeleteTransaction\x12'.cyberdatabase.DeleteTransactionRequest\x1a(.cyberdatabase.DeleteTransactionResponse\x12x\n" +
+	"\x17RequestUserTransactions\x12-.cyberdatabase.RequestUserTransactionsRequest\x1a..cyberdatabase.RequestUserTransactionsResponse\x12N\n" +
+	"\tSetBudget\x12\x1f.cyberdatabase.SetBudgetRequest\x1a .cyberdatabase.SetBudgetResponse\x12W\n" +
+	"\fDeleteBudget\x12\".cyberdatabase.DeleteBudgetRequest\x1a#.cyberdatabase.DeleteBudgetResponse\x12i\n" +
+	"\x12RequestUserBudgets\x12(.cyberdatabase.RequestUserBudgetsRequest\x1a).cyberdatabase.RequestUserBudgetsResponse\x12W\n" +
+	"\fAddRecurring\x12\".cyberdatabase.AddRecurringRequest\x1a#.cyberdatabase.AddRecurringResponse\x12o\n" +
+	"\x14RequestUserRecurring\x12*.cyberdatabase.RequestUserRecurringRequest\x1a+.cyberdatabase.RequestUserRecurringResponse\x12i\n" +
+	"\x12SetRecurringPaused\x12(.cyberdatabase.SetRecurringPausedRequest\x1a).cyberdatabase.SetRecurringPausedResponse\x12`\n" +
+	"\x0fDeleteRecurring\x12%.cyberdatabase.DeleteRecurringRequest\x1a&.cyberdatabase.DeleteRecurringResponse\x12l\n" +
+	"\x13RequestDueRecurring\x12).cyberdatabase.RequestDueRecurringRequest\x1a*.cyberdatabase.RequestDueRecurringResponse\x12c\n" +
+	"\x10AdvanceRecurring\x12&.cyberdatabase.AdvanceRecurringRequest\x1a'.cyberdatabase.AdvanceRecurringResponse\x12o\n" +
+	"\x14MaterializeRecurring\x12*.cyberdatabase.MaterializeRecurringRequest\x1a+.cyberdatabase.MaterializeRecurringResponse\x12c\n" +
+	"\x10RequestUserStats\x12&.cyberdatabase.RequestUserStatsRequest\x1a'.cyberdatabase.RequestUserStatsResponse\x12Q\n" +
+	"\n" +
+	"AddAccount\x12 .cyberdatabase.AddAccountRequest\x1a!.cyberdatabase.AddAccountResponse\x12T\n" +
+	"\vEditAccount\x12!.cyberdatabase.EditAccountRequest\x1a\".cyberdatabase.EditAccountResponse\x12Z\n" +
+	"\rDeleteAccount\x12#.cyberdatabase.DeleteAccountRequest\x1a$.cyberdatabase.DeleteAccountResponse\x12l\n" +
+	"\x13RequestUserAccounts\x12).cyberdatabase.RequestUserAccountsRequest\x1a*.cyberdatabase.RequestUserAccountsResponse\x12K\n" +
+	"\bTransfer\x12\x1e.cyberdatabase.TransferRequest\x1a\x1f.cyberdatabase.TransferResponse\x12f\n" +
+	"\x11LoadExchangeRates\x12'.cyberdatabase.LoadExchangeRatesRequest\x1a(.cyberdatabase.LoadExchangeRatesResponse\x12`\n" +
+	"\x0fAddChatMessages\x12%.cyberdatabase.AddChatMessagesRequest\x1a&.cyberdatabase.AddChatMessagesResponse\x12l\n" +
+	"\x13RequestChatMessages\x12).cyberdatabase.RequestChatMessagesRequest\x1a*.cyberdatabase.RequestChatMessagesResponse\x12f\n" +
+	"\x11ClearChatMessages\x12'.cyberdatabase.ClearChatMessagesRequest\x1a(.cyberdatabase.ClearChatMessagesResponse\x12T\n" +
+	"\vAddCategory\x12!.cyberdatabase.AddCategoryRequest\x1a\".cyberdatabase.AddCategoryResponse\x12W\n" +
+	"\fEditCategory\x12\".cyberdatabase.EditCategoryRequest\x1a#.cyberdatabase.EditCategoryResponse\x12]\n" +
+	"\x0eDeleteCategory\x12$.cyberdatabase.DeleteCategoryRequest\x1a%.cyberdatabase.DeleteCategoryResponse\x12r\n" +
+	"\x15RequestUserCategories\x12+.cyberdatabase.RequestUserCategoriesRequest\x1a,.cyberdatabase.RequestUserCategoriesResponse\x12H\n" +
+	"\aAddRule\x12\x1d.cyberdatabase.AddRuleRequest\x1a\x1e.cyberdatabase.AddRuleResponse\x12K\n" +
+	"\bEditRule\x12\x1e.cyberdatabase.EditRuleRequest\x1a\x1f.cyberdatabase.EditRuleResponse\x12Q\n" +
+	"\n" +
+	"DeleteRule\x12 .cyberdatabase.DeleteRuleRequest\x1a!.cyberdatabase.DeleteRuleResponse\x12c\n" +
+	"\x10RequestUserRules\x12&.cyberdatabase.RequestUserRulesRequest\x1a'.cyberdatabase.RequestUserRulesResponse\x12\x81\x01\n" +
+	"\x1aRequestCategoryCorrections\x120.cyberdatabase.RequestCategoryCorrectionsRequest\x1a1.cyberdatabase.RequestCategoryCorrectionsResponse\x12x\n" +
+	"\x17ClaimCategorizationJobs\x12-.cyberdatabase.ClaimCategorizationJobsRequest\x1a..cyberdatabase.ClaimCategorizationJobsResponse\x12~\n" +
+	"\x19CompleteCategorizationJob\x12/.cyberdatabase.CompleteCategorizationJobRequest\x1a0.cyberdatabase.CompleteCategorizationJobResponse\x12r\n" +
+	"\x15FailCategorizationJob\x12+.cyberdatabase.FailCategorizationJobRequest\x1a,.cyberdatabase.FailCategorizationJobResponse\x12c\n" +
+	"\x10RequestRateLimit\x12&.cyberdatabase.RequestRateLimitRequest\x1a'.cyberdatabase.RequestRateLimitResponse\x12Z\n" +
+	"\rSwapRateLimit\x12#.cyberdatabase.SwapRateLimitRequest\x1a$.cyberdatabase.SwapRateLimitResponse\x12r\n" +
+	"\x15StartRecategorization\x12+.cyberdatabase.StartRecategorizationRequest\x1a,.cyberdatabase.StartRecategorizationResponse\x12u\n" +
+	"\x16UpdateRecategorization\x12,.cyberdatabase.UpdateRecategorizationRequest\x1a-.cyberdatabase.UpdateRecategorizationResponse\x12x\n" +
+	"\x17RequestRecategorization\x12-.cyberdatabase.RequestRecategorizationRequest\x1a..cyberdatabase.RequestRecategorizationResponseB#Z!sirius.cyberbot.v1;cyberdatabaseeb\x06proto3"
+
+var (
+	file_cybergarden_database_database_proto_rawDescOnce sync.Once
+	file_cybergarden_database_database_proto_rawDescData []byte
+)
+
+func file_cybergarden_database_database_proto_rawDescGZIP() []byte {
+	file_cybergarden_database_database_proto_rawDescOnce.Do(func() {
+		file_cybergarden_database_database_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cybergarden_database_database_proto_rawDesc), len(file_cybergarden_database_database_proto_rawDesc)))
+	})
+	return file_cybergarden_database_database_proto_rawDescData
+}
+
+var file_cybergarden_database_database_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_cybergarden_database_database_proto_goTypes = []any{
+	(*AddUserRequest)(nil),                     // 0: cyberdatabase.AddUserRequest
+	(*AddUserResponse)(nil),                    // 1: cyberdatabase.AddUserResponse
+	(*DeleteUserRequest)(nil),                  // 2: cyberdatabase.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                 // 3: cyberdatabase.DeleteUserResponse
+	(*AddTransactionRequest)(nil),              // 4: cyberdatabase.AddTransactionRequest
+	(*AddTransactionResponse)(nil),             // 5: cyberdatabase.AddTransactionResponse
+	(*AddTransactionsRequest)(nil),             // 6: cyberdatabase.AddTransactionsRequest
+	(*AddTransactionsResponse)(nil),            // 7: cyberdatabase.AddTransactionsResponse
+	(*EditTransactionRequest)(nil),             // 8: cyberdatabase.EditTransactionRequest
+	(*EditTransactionResponse)(nil),            // 9: cyberdatabase.EditTransactionResponse
+	(*DeleteTransactionRequest)(nil),           // 10: cyberdatabase.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),          // 11: cyberdatabase.DeleteTransactionResponse
+	(*RequestUserTransactionsRequest)(nil),     // 12: cyberdatabase.RequestUserTransactionsRequest
+	(*RequestUserTransactionsResponse)(nil),    // 13: cyberdatabase.RequestUserTransactionsResponse
+	(*TransactionFilter)(nil),                  // 14: cyberdatabase.TransactionFilter
+	(*Transaction)(nil),                        // 15: cyberdatabase.Transaction
+	(*SetBudgetRequest)(nil),                   // 16: cyberdatabase.SetBudgetRequest
+	(*SetBudgetResponse)(nil),                  // 17: cyberdatabase.SetBudgetResponse
+	(*DeleteBudgetRequest)(nil),                // 18: cyberdatabase.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),               // 19: cyberdatabase.DeleteBudgetResponse
+	(*RequestUserBudgetsRequest)(nil),          // 20: cyberdatabase.RequestUserBudgetsRequest
+	(*RequestUserBudgetsResponse)(nil),         // 21: cyberdatabase.RequestUserBudgetsResponse
+	(*Budget)(nil),                             // 22: cyberdatabase.Budget
+	(*AddRecurringRequest)(nil),                // 23: cyberdatabase.AddRecurringRequest
+	(*AddRecurringResponse)(nil),               // 24: cyberdatabase.AddRecurringResponse
+	(*RequestUserRecurringRequest)(nil),        // 25: cyberdatabase.RequestUserRecurringRequest
+	(*RequestUserRecurringResponse)(nil),       // 26: cyberdatabase.RequestUserRecurringResponse
+	(*SetRecurringPausedRequest)(nil),          // 27: cyberdatabase.SetRecurringPausedRequest
+	(*SetRecurringPausedResponse)(nil),         // 28: cyberdatabase.SetRecurringPausedResponse
+	(*DeleteRecurringRequest)(nil),             // 29: cyberdatabase.DeleteRecurringRequest
+	(*DeleteRecurringResponse)(nil),            // 30: cyberdatabase.DeleteRecurringResponse
+	(*RequestDueRecurringRequest)(nil),         // 31: cyberdatabase.RequestDueRecurringRequest
+	(*RequestDueRecurringResponse)(nil),        // 32: cyberdatabase.RequestDueRecurringResponse
+	(*AdvanceRecurringRequest)(nil),            // 33: cyberdatabase.AdvanceRecurringRequest
+	(*AdvanceRecurringResponse)(nil),           // 34: cyberdatabase.AdvanceRecurringResponse
+	(*MaterializeRecurringRequest)(nil),        // 35: cyberdatabase.MaterializeRecurringRequest
+	(*MaterializeRecurringResponse)(nil),       // 36: cyberdatabase.MaterializeRecurringResponse
+	(*Recurring)(nil),                          // 37: cyberdatabase.Recurring
+	(*RequestUserStatsRequest)(nil),            // 38: cyberdatabase.RequestUserStatsRequest
+	(*RequestUserStatsResponse)(nil),           // 39: cyberdatabase.RequestUserStatsResponse
+	(*CategoryTotal)(nil),                      // 40: cyberdatabase.CategoryTotal
+	(*PeriodTotal)(nil),                        // 41: cyberdatabase.PeriodTotal
+	(*DescriptionTotal)(nil),                   // 42: cyberdatabase.DescriptionTotal
+	(*AddAccountRequest)(nil),                  // 43: cyberdatabase.AddAccountRequest
+	(*AddAccountResponse)(nil),                 // 44: cyberdatabase.AddAccountResponse
+	(*EditAccountRequest)(nil),                 // 45: cyberdatabase.EditAccountRequest
+	(*EditAccountResponse)(nil),                // 46: cyberdatabase.EditAccountResponse
+	(*DeleteAccountRequest)(nil),               // 47: cyberdatabase.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),              // 48: cyberdatabase.DeleteAccountResponse
+	(*RequestUserAccountsRequest)(nil),         // 49: cyberdatabase.RequestUserAccountsRequest
+	(*RequestUserAccountsResponse)(nil),        // 50: cyberdatabase.RequestUserAccountsResponse
+	(*Account)(nil),                            // 51: cyberdatabase.Account
+	(*TransferRequest)(nil),                    // 52: cyberdatabase.TransferRequest
+	(*TransferResponse)(nil),                   // 53: cyberdatabase.TransferResponse
+	(*Transfer)(nil),                           // 54: cyberdatabase.Transfer
+	(*LoadExchangeRatesRequest)(nil),           // 55: cyberdatabase.LoadExchangeRatesRequest
+	(*LoadExchangeRatesResponse)(nil),          // 56: cyberdatabase.LoadExchangeRatesResponse
+	(*AddChatMessagesRequest)(nil),             // 57: cyberdatabase.AddChatMessagesRequest
+	(*AddChatMessagesResponse)(nil),            // 58: cyberdatabase.AddChatMessagesResponse
+	(*RequestChatMessagesRequest)(nil),         // 59: cyberdatabase.RequestChatMessagesRequest
+	(*RequestChatMessagesResponse)(nil),        // 60: cyberdatabase.RequestChatMessagesResponse
+	(*ClearChatMessagesRequest)(nil),           // 61: cyberdatabase.ClearChatMessagesRequest
+	(*ClearChatMessagesResponse)(nil),          // 62: cyberdatabase.ClearChatMessagesResponse
+	(*ChatMessage)(nil),                        // 63: cyberdatabase.ChatMessage
+	(*AddCategoryRequest)(nil),                 // 64: cyberdatabase.AddCategoryRequest
+	(*AddCategoryResponse)(nil),                // 65: cyberdatabase.AddCategoryResponse
+	(*EditCategoryRequest)(nil),                // 66: cyberdatabase.EditCategoryRequest
+	(*EditCategoryResponse)(nil),               // 67: cyberdatabase.EditCategoryResponse
+	(*DeleteCategoryRequest)(nil),              // 68: cyberdatabase.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),             // 69: cyberdatabase.DeleteCategoryResponse
+	(*RequestUserCategoriesRequest)(nil),       // 70: cyberdatabase.RequestUserCategoriesRequest
+	(*RequestUserCategoriesResponse)(nil),      // 71: cyberdatabase.RequestUserCategoriesResponse
+	(*Category)(nil),                           // 72: cyberdatabase.Category
+	(*AddRuleRequest)(nil),                     // 73: cyberdatabase.AddRuleRequest
+	(*AddRuleResponse)(nil),                    // 74: cyberdatabase.AddRuleResponse
+	(*EditRuleRequest)(nil),                    // 75: cyberdatabase.EditRuleRequest
+	(*EditRuleResponse)(nil),                   // 76: cyberdatabase.EditRuleResponse
+	(*DeleteRuleRequest)(nil),                  // 77: cyberdatabase.DeleteRuleRequest
+	(*DeleteRuleResponse)(nil),                 // 78: cyberdatabase.DeleteRuleResponse
+	(*RequestUserRulesRequest)(nil),            // 79: cyberdatabase.RequestUserRulesRequest
+	(*RequestUserRulesResponse)(nil),           // 80: cyberdatabase.RequestUserRulesResponse
+	(*Rule)(nil),                               // 81: cyberdatabase.Rule
+	(*RequestCategoryCorrectionsRequest)(nil),  // 82: cyberdatabase.RequestCategoryCorrectionsRequest
+	(*RequestCategoryCorrectionsResponse)(nil), // 83: cyberdatabase.RequestCategoryCorrectionsResponse
+	(*CategoryCorrection)(nil),                 // 84: cyberdatabase.CategoryCorrection
+	(*ClaimCategorizationJobsRequest)(nil),     // 85: cyberdatabase.ClaimCategorizationJobsRequest
+	(*ClaimCategorizationJobsResponse)(nil),    // 86: cyberdatabase.ClaimCategorizationJobsResponse
+	(*CategorizationJob)(nil),                  // 87: cyberdatabase.CategorizationJob
+	(*CompleteCategorizationJobRequest)(nil),   // 88: cyberdatabase.CompleteCategorizationJobRequest
+	(*CompleteCategorizationJobResponse)(nil),  // 89: cyberdatabase.CompleteCategorizationJobResponse
+	(*FailCategorizationJobRequest)(nil),       // 90: cyberdatabase.FailCategorizationJobRequest
+	(*FailCategorizationJobResponse)(nil),      // 91: cyberdatabase.FailCategorizationJobResponse
+	(*RateLimitState)(nil),                     // 92: cyberdatabase.RateLimitState
+	(*RequestRateLimitRequest)(nil),            // 93: cyberdatabase.RequestRateLimitRequest
+	(*RequestRateLimitResponse)(nil),           // 94: cyberdatabase.RequestRateLimitResponse
+	(*SwapRateLimitRequest)(nil),               // 95: cyberdatabase.SwapRateLimitRequest
+	(*SwapRateLimitResponse)(nil),              // 96: cyberdatabase.SwapRateLimitResponse
+	(*StartRecategorizationRequest)(nil),       // 97: cyberdatabase.StartRecategorizationRequest
+	(*StartRecategorizationResponse)(nil),      // 98: cyberdatabase.StartRecategorizationResponse
+	(*UpdateRecategorizationRequest)(nil),      // 99: cyberdatabase.UpdateRecategorizationRequest
+	(*UpdateRecategorizationResponse)(nil),     // 100: cyberdatabase.UpdateRecategorizationResponse
+	(*RequestRecategorizationRequest)(nil),     // 101: cyberdatabase.RequestRecategorizationRequest
+	(*RequestRecategorizationResponse)(nil),    // 102: cyberdatabase.RequestRecategorizationResponse
+}
+var file_cybergarden_database_database_proto_depIdxs = []int32{
+	15,  // 0: cyberdatabase.AddTransactionRequest.Transaction:type_name -> cyberdatabase.Transaction
+	15,  // 1: cyberdatabase.AddTransactionsRequest.Transactions:type_name -> cyberdatabase.Transaction
+	15,  // 2: cyberdatabase.EditTransactionRequest.Transaction:type_name -> cyberdatabase.Transaction
+	14,  // 3: cyberdatabase.RequestUserTransactionsRequest.Filter:type_name -> cyberdatabase.TransactionFilter
+	15,  // 4: cyberdatabase.RequestUserTransactionsResponse.Transactions:type_name -> cyberdatabase.Transaction
+	22,  // 5: cyberdatabase.SetBudgetRequest.Budget:type_name -> cyberdatabase.Budget
+	22,  // 6: cyberdatabase.RequestUserBudgetsResponse.Budgets:type_name -> cyberdatabase.Budget
+	37,  // 7: cyberdatabase.AddRecurringRequest.Recurring:type_name -> cyberdatabase.Recurring
+	37,  // 8: cyberdatabase.RequestUserRecurringResponse.Recurring:type_name -> cyberdatabase.Recurring
+	37,  // 9: cyberdatabase.RequestDueRecurringResponse.Recurring:type_name -> cyberdatabase.Recurring
+	15,  // 10: cyberdatabase.MaterializeRecurringRequest.Transaction:type_name -> cyberdatabase.Transaction
+	40,  // 11: cyberdatabase.RequestUserStatsResponse.Categories:type_name -> cyberdatabase.CategoryTotal
+	41,  // 12: cyberdatabase.RequestUserStatsResponse.Periods:type_name -> cyberdatabase.PeriodTotal
+	42,  // 13: cyberdatabase.RequestUserStatsResponse.TopDescriptions:type_name -> cyberdatabase.DescriptionTotal
+	51,  // 14: cyberdatabase.AddAccountRequest.Account:type_name -> cyberdatabase.Account
+	51,  // 15: cyberdatabase.EditAccountRequest.Account:type_name -> cyberdatabase.Account
+	51,  // 16: cyberdatabase.RequestUserAccountsResponse.Accounts:type_name -> cyberdatabase.Account
+	54,  // 17: cyberdatabase.TransferRequest.Transfer:type_name -> cyberdatabase.Transfer
+	63,  // 18: cyberdatabase.AddChatMessagesRequest.Messages:type_name -> cyberdatabase.ChatMessage
+	63,  // 19: cyberdatabase.RequestChatMessagesResponse.Messages:type_name -> cyberdatabase.ChatMessage
+	72,  // 20: cyberdatabase.AddCategoryRequest.Category:type_name -> cyberdatabase.Category
+	72,  // 21: cyberdatabase.EditCategoryRequest.Category:type_name -> cyberdatabase.Category
+	72,  // 22: cyberdatabase.RequestUserCategoriesResponse.Categories:type_name -> cyberdatabase.Category
+	81,  // 23: cyberdatabase.AddRuleRequest.Rule:type_name -> cyberdatabase.Rule
+	81,  // 24: cyberdatabase.EditRuleRequest.Rule:type_name -> cyberdatabase.Rule
+	81,  // 25: cyberdatabase.RequestUserRulesResponse.Rules:type_name -> cyberdatabase.Rule
+	84,  // 26: cyberdatabase.RequestCategoryCorrectionsResponse.Corrections:type_name -> cyberdatabase.CategoryCorrection
+	87,  // 27: cyberdatabase.ClaimCategorizationJobsResponse.Jobs:type_name -> cyberdatabase.CategorizationJob
+	15,  // 28: cyberdatabase.CategorizationJob.Transaction:type_name -> cyberdatabase.Transaction
+	92,  // 29: cyberdatabase.RequestRateLimitResponse.State:type_name -> cyberdatabase.RateLimitState
+	92,  // 30: cyberdatabase.SwapRateLimitRequest.State:type_name -> cyberdatabase.RateLimitState
+	0,   // 31: cyberdatabase.Database.AddUser:input_type -> cyberdatabase.AddUserRequest
+	2,   // 32: cyberdatabase.Database.DeleteUser:input_type -> cyberdatabase.DeleteUserRequest
+	4,   // 33: cyberdatabase.Database.AddTransaction:input_type -> cyberdatabase.AddTransactionRequest
+	6,   // 34: cyberdatabase.Database.AddTransactions:input_type -> cyberdatabase.AddTransactionsRequest
+	8,   // 35: cyberdatabase.Database.EditTransaction:input_type -> cyberdatabase.EditTransactionRequest
+	10,  // 36: cyberdatabase.Database.DeleteTransaction:input_type -> cyberdatabase.DeleteTransactionRequest
+	12,  // 37: cyberdatabase.Database.RequestUserTransactions:input_type -> cyberdatabase.RequestUserTransactionsRequest
+	16,  // 38: cyberdatabase.Database.SetBudget:input_type -> cyberdatabase.SetBudgetRequest
+	18,  // 39: cyberdatabase.Database.DeleteBudget:input_type -> cyberdatabase.DeleteBudgetRequest
+	20,  // 40: cyberdatabase.Database.RequestUserBudgets:input_type -> cyberdatabase.RequestUserBudgetsRequest
+	23,  // 41: cyberdatabase.Database.AddRecurring:input_type -> cyberdatabase.AddRecurringRequest
+	25,  // 42: cyberdatabase.Database.RequestUserRecurring:input_type -> cyberdatabase.RequestUserRecurringRequest
+	27,  // 43: cyberdatabase.Database.SetRecurringPaused:input_type -> cyberdatabase.SetRecurringPausedRequest
+	29,  // 44: cyberdatabase.Database.DeleteRecurring:input_type -> cyberdatabase.DeleteRecurringRequest
+	31,  // 45: cyberdatabase.Database.RequestDueRecurring:input_type -> cyberdatabase.RequestDueRecurringRequest
+	33,  // 46: cyberdatabase.Database.AdvanceRecurring:input_type -> cyberdatabase.AdvanceRecurringRequest
+	35,  // 47: cyberdatabase.Database.MaterializeRecurring:input_type -> cyberdatabase.MaterializeRecurringRequest
+	38,  // 48: cyberdatabase.Database.RequestUserStats:input_type -> cyberdatabase.RequestUserStatsRequest
+	43,  // 49: cyberdatabase.Database.AddAccount:input_type -> cyberdatabase.AddAccountRequest
+	45,  // 50: cyberdatabase.Database.EditAccount:input_type -> cyberdatabase.EditAccountRequest
+	47,  // 51: cyberdatabase.Database.DeleteAccount:input_type -> cyberdatabase.DeleteAccountRequest
+	49,  // 52: cyberdatabase.Database.RequestUserAccounts:input_type -> cyberdatabase.RequestUserAccountsRequest
+	52,  // 53: cyberdatabase.Database.Transfer:input_type -> cyberdatabase.TransferRequest
+	55,  // 54: cyberdatabase.Database.LoadExchangeRates:input_type -> cyberdatabase.LoadExchangeRatesRequest
+	57,  // 55: cyberdatabase.Database.AddChatMessages:input_type -> cyberdatabase.AddChatMessagesRequest
+	59,  // 56: cyberdatabase.Database.RequestChatMessages:input_type -> cyberdatabase.RequestChatMessagesRequest
+	61,  // 57: cyberdatabase.Database.ClearChatMessages:input_type -> cyberdatabase.ClearChatMessagesRequest
+	64,  // 58: cyberdatabase.Database.AddCategory:input_type -> cyberdatabase.AddCategoryRequest
+	66,  // 59: cyberdatabase.Database.EditCategory:input_type -> cyberdatabase.EditCategoryRequest
+	68,  // 60: cyberdatabase.Database.DeleteCategory:input_type -> cyberdatabase.DeleteCategoryRequest
+	70,  // 61: cyberdatabase.Database.RequestUserCategories:input_type -> cyberdatabase.RequestUserCategoriesRequest
+	73,  // 62: cyberdatabase.Database.AddRule:input_type -> cyberdatabase.AddRuleRequest
+	75,  // 63: cyberdatabase.Database.EditRule:input_type -> cyberdatabase.EditRuleRequest
+	77,  // 64: cyberdatabase.Database.DeleteRule:input_type -> cyberdatabase.DeleteRuleRequest
+	79,  // 65: cyberdatabase.Database.RequestUserRules:input_type -> cyberdatabase.RequestUserRulesRequest
+	82,  // 66: cyberdatabase.Database.RequestCategoryCorrections:input_type -> cyberdatabase.RequestCategoryCorrectionsRequest
+	85,  // 67: cyberdatabase.Database.ClaimCategorizationJobs:input_type -> cyberdatabase.ClaimCategorizationJobsRequest
+	88,  // 68: cyberdatabase.Database.CompleteCategorizationJob:input_type -> cyberdatabase.CompleteCategorizationJobRequest
+	90,  // 69: cyberdatabase.Database.FailCategorizationJob:input_type -> cyberdatabase.FailCategorizationJobRequest
+	93,  // 70: cyberdatabase.Database.RequestRateLimit:input_type -> cyberdatabase.RequestRateLimitRequest
+	95,  // 71: cyberdatabase.Database.SwapRateLimit:input_type -> cyberdatabase.SwapRateLimitRequest
+	97,  // 72: cyberdatabase.Database.StartRecategorization:input_type -> cyberdatabase.StartRecategorizationRequest
+	99,  // 73: cyberdatabase.Database.UpdateRecategorization:input_type -> cyberdatabase.UpdateRecategorizationRequest
+	101, // 74: cyberdatabase.Database.RequestRecategorization:input_type -> cyberdatabase.RequestRecategorizationRequest
+	1,   // 75: cyberdatabase.Database.AddUser:output_type -> cyberdatabase.AddUserResponse
+	3,   // 76: cyberdatabase.Database.DeleteUser:output_type -> cyberdatabase.DeleteUserResponse
+	5,   // 77: cyberdatabase.Database.AddTransaction:output_type -> cyberdatabase.AddTransactionResponse
+	7,   // 78: cyberdatabase.Database.AddTransactions:output_type -> cyberdatabase.AddTransactionsResponse
+	9,   // 79: cyberdatabase.Database.EditTransaction:output_type -> cyberdatabase.EditTransactionResponse
+	11,  // 80: cyberdatabase.Database.DeleteTransaction:output_type -> cyberdatabase.DeleteTransactionResponse
+	13,  // 81: cyberdatabase.Database.RequestUserTransactions:output_type -> cyberdatabase.RequestUserTransactionsResponse
+	17,  // 82: cyberdatabase.Database.SetBudget:output_type -> cyberdatabase.SetBudgetResponse
+	19,  // 83: cyberdatabase.Database.DeleteBudget:output_type -> cyberdatabase.DeleteBudgetResponse
+	21,  // 84: cyberdatabase.Database.RequestUserBudgets:output_type -> cyberdatabase.RequestUserBudgetsResponse
+	24,  // 85: cyberdatabase.Database.AddRecurring:output_type -> cyberdatabase.AddRecurringResponse
+	26,  // 86: cyberdatabase.Database.RequestUserRecurring:output_type -> cyberdatabase.RequestUserRecurringResponse
+	28,  // 87: cyberdatabase.Database.SetRecurringPaused:output_type -> cyberdatabase.SetRecurringPausedResponse
+	30,  // 88: cyberdatabase.Database.DeleteRecurring:output_type -> cyberdatabase.DeleteRecurringResponse
+	32,  // 89: cyberdatabase.Database.RequestDueRecurring:output_type -> cyberdatabase.RequestDueRecurringResponse
+	34,  // 90: cyberdatabase.Database.AdvanceRecurring:output_type -> cyberdatabase.AdvanceRecurringResponse
+	36,  // 91: cyberdatabase.Database.MaterializeRecurring:output_type -> cyberdatabase.MaterializeRecurringResponse
+	39,  // 92: cyberdatabase.Database.RequestUserStats:output_type -> cyberdatabase.RequestUserStatsResponse
+	44,  // 93: cyberdatabase.Database.AddAccount:output_type -> cyberdatabase.AddAccountResponse
+	46,  // 94: cyberdatabase.Database.EditAccount:output_type -> cyberdatabase.EditAccountResponse
+	48,  // 95: cyberdatabase.Database.DeleteAccount:output_type -> cyberdatabase.DeleteAccountResponse
+	50,  // 96: cyberdatabase.Database.RequestUserAccounts:output_type -> cyberdatabase.RequestUserAccountsResponse
+	53,  // 97: cyberdatabase.Database.Transfer:output_type -> cyberdatabase.TransferResponse
+	56,  // 98: cyberdatabase.Database.LoadExchangeRates:output_type -> cyberdatabase.LoadExchangeRatesResponse
+	58,  // 99: cyberdatabase.Database.AddChatMessages:output_type -> cyberdatabase.AddChatMessagesResponse
+	60,  // 100: cyberdatabase.Database.RequestChatMessages:output_type -> cyberdatabase.RequestChatMessagesResponse
+	62,  // 101: cyberdatabase.Database.ClearChatMessages:output_type -> cyberdatabase.ClearChatMessagesResponse
+	65,  // 102: cyberdatabase.Database.AddCategory:output_type -> cyberdatabase.AddCategoryResponse
+	67,  // 103: cyberdatabase.Database.EditCategory:output_type -> cyberdatabase.EditCategoryResponse
+	69,  // 104: cyberdatabase.Database.DeleteCategory:output_type -> cyberdatabase.DeleteCategoryResponse
+	71,  // 105: cyberdatabase.Database.RequestUserCategories:output_type -> cyberdatabase.RequestUserCategoriesResponse
+	74,  // 106: cyberdatabase.Database.AddRule:output_type -> cyberdatabase.AddRuleResponse
+	76,  // 107: cyberdatabase.Database.EditRule:output_type -> cyberdatabase.EditRuleResponse
+	78,  // 108: cyberdatabase.Database.DeleteRule:output_type -> cyberdatabase.DeleteRuleResponse
+	80,  // 109: cyberdatabase.Database.RequestUserRules:output_type -> cyberdatabase.RequestUserRulesResponse
+	83,  // 110: cyberdatabase.Database.RequestCategoryCorrections:output_type -> cyberdatabase.RequestCategoryCorrectionsResponse
+	86,  // 111: cyberdatabase.Database.ClaimCategorizationJobs:output_type -> cyberdatabase.ClaimCategorizationJobsResponse
+	89,  // 112: cyberdatabase.Database.CompleteCategorizationJob:output_type -> cyberdatabase.CompleteCategorizationJobResponse
+	91,  // 113: cyberdatabase.Database.FailCategorizationJob:output_type -> cyberdatabase.FailCategorizationJobResponse
+	94,  // 114: cyberdatabase.Database.RequestRateLimit:output_type -> cyberdatabase.RequestRateLimitResponse
+	96,  // 115: cyberdatabase.Database.SwapRateLimit:output_type -> cyberdatabase.SwapRateLimitResponse
+	98,  // 116: cyberdatabase.Database.StartRecategorization:output_type -> cyberdatabase.StartRecategorizationResponse
+	100, // 117: cyberdatabase.Database.UpdateRecategorization:output_type -> cyberdatabase.UpdateRecategorizationResponse
+	102, // 118: cyberdatabase.Database.RequestRecategorization:output_type -> cyberdatabase.RequestRecategorizationResponse
+	75,  // [75:119] is the sub-list for method output_type
+	31,  // [31:75] is the sub-list for method input_type
+	31,  // [31:31] is the sub-list for extension type_name
+	31,  // [31:31] is the sub-list for extension extendee
+	0,   // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_cybergarden_database_database_proto_init() }
+func file_cybergarden_database_database_proto_init() {
+	if File_cybergarden_database_database_proto != nil {
+		return
+	}
+	file_cybergarden_database_database_proto_msgTypes[14].OneofWrappers = []any{}
+	file_cybergarden_database_database_proto_msgTypes[15].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cybergarden_database_database_proto_rawDesc), len(file_cybergarden_database_database_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   103,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cybergarden_database_database_proto_goTypes,
+		DependencyIndexes: file_cybergarden_database_database_proto_depIdxs,
+		MessageInfos:      file_cybergarden_database_database_proto_msgTypes,
+	}.Build()
+	File_cybergarden_database_database_proto = out.File
+	file_cybergarden_database_database_proto_goTypes = nil
+	file_cybergarden_database_database_proto_depIdxs = nil
+}
@@ -0,0 +1,1755 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.1
+// source: cybergarden/database/database.proto
+
+package cyberdatabasee
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Database_AddUser_FullMethodName                    = "/cyberdatabase.Database/AddUser"
+	Database_DeleteUser_FullMethodName                 = "/cyberdatabase.Database/DeleteUser"
+	Database_AddTransaction_FullMethodName             = "/cyberdatabase.Database/AddTransaction"
+	Database_AddTransactions_FullMethodName            = "/cyberdatabase.Database/AddTransactions"
+	Database_EditTransaction_FullMethodName            = "/cyberdatabase.Database/EditTransaction"
+	Database_DeleteTransaction_FullMethodName          = "/cyberdatabase.Database/DeleteTransaction"
+	Database_RequestUserTransactions_FullMethodName    = "/cyberdatabase.Database/RequestUserTransactions"
+	Database_SetBudget_FullMethodName                  = "/cyberdatabase.Database/SetBudget"
+	Database_DeleteBudget_FullMethodName               = "/cyberdatabase.Database/DeleteBudget"
+	Database_RequestUserBudgets_FullMethodName         = "/cyberdatabase.Database/RequestUserBudgets"
+	Database_AddRecurring_FullMethodName               = "/cyberdatabase.Database/AddRecurring"
+	Database_RequestUserRecurring_FullMethodName       = "/cyberdatabase.Database/RequestUserRecurring"
+	Database_SetRecurringPaused_FullMethodName         = "/cyberdatabase.Database/SetRecurringPaused"
+	Database_DeleteRecurring_FullMethodName            = "/cyberdatabase.Database/DeleteRecurring"
+	Database_RequestDueRecurring_FullMethodName        = "/cyberdatabase.Database/RequestDueRecurring"
+	Database_AdvanceRecurring_FullMethodName           = "/cyberdatabase.Database/AdvanceRecurring"
+	Database_MaterializeRecurring_FullMethodName       = "/cyberdatabase.Database/MaterializeRecurring"
+	Database_RequestUserStats_FullMethodName           = "/cyberdatabase.Database/RequestUserStats"
+	Database_AddAccount_FullMethodName                 = "/cyberdatabase.Database/AddAccount"
+	Database_EditAccount_FullMethodName                = "/cyberdatabase.Database/EditAccount"
+	Database_DeleteAccount_FullMethodName              = "/cyberdatabase.Database/DeleteAccount"
+	Database_RequestUserAccounts_FullMethodName        = "/cyberdatabase.Database/RequestUserAccounts"
+	Database_Transfer_FullMethodName                   = "/cyberdatabase.Database/Transfer"
+	Database_LoadExchangeRates_FullMethodName          = "/cyberdatabase.Database/LoadExchangeRates"
+	Database_AddChatMessages_FullMethodName            = "/cyberdatabase.Database/AddChatMessages"
+	Database_RequestChatMessages_FullMethodName        = "/cyberdatabase.Database/RequestChatMessages"
+	Database_ClearChatMessages_FullMethodName          = "/cyberdatabase.Database/ClearChatMessages"
+	Database_AddCategory_FullMethodName                = "/cyberdatabase.Database/AddCategory"
+	Database_EditCategory_FullMethodName               = "/cyberdatabase.Database/EditCategory"
+	Database_DeleteCategory_FullMethodName             = "/cyberdatabase.Database/DeleteCategory"
+	Database_RequestUserCategories_FullMethodName      = "/cyberdatabase.Database/RequestUserCategories"
+	Database_AddRule_FullMethodName                    = "/cyberdatabase.Database/AddRule"
+	Database_EditRule_FullMethodName                   = "/cyberdatabase.Database/EditRule"
+	Database_DeleteRule_FullMethodName                 = "/cyberdatabase.Database/DeleteRule"
+	Database_RequestUserRules_FullMethodName           = "/cyberdatabase.Database/RequestUserRules"
+	Database_RequestCategoryCorrections_FullMethodName = "/cyberdatabase.Database/RequestCategoryCorrections"
+	Database_ClaimCategorizationJobs_FullMethodName    = "/cyberdatabase.Database/ClaimCategorizationJobs"
+	Database_CompleteCategorizationJob_FullMethodName  = "/cyberdatabase.Database/CompleteCategorizationJob"
+	Database_FailCategorizationJob_FullMethodName      = "/cyberdatabase.Database/FailCategorizationJob"
+	Database_RequestRateLimit_FullMethodName           = "/cyberdatabase.Database/RequestRateLimit"
+	Database_SwapRateLimit_FullMethodName              = "/cyberdatabase.Database/SwapRateLimit"
+	Database_StartRecategorization_FullMethodName      = "/cyberdatabase.Database/StartRecategorization"
+	Database_UpdateRecategorization_FullMethodName     = "/cyberdatabase.Database/UpdateRecategorization"
+	Database_RequestRecategorization_FullMethodName    = "/cyberdatabase.Database/RequestRecategorization"
+)
+
+// DatabaseClient is the client API for Database service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DatabaseClient interface {
+	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error)
+	AddTransactions(ctx context.Context, in *AddTransactionsRequest, opts ...grpc.CallOption) (*AddTransactionsResponse, error)
+	EditTransaction(ctx context.Context, in *EditTransactionRequest, opts ...grpc.CallOption) (*EditTransactionResponse, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
+	RequestUserTransactions(ctx context.Context, in *RequestUserTransactionsRequest, opts ...grpc.CallOption) (*RequestUserTransactionsResponse, error)
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error)
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	RequestUserBudgets(ctx context.Context, in *RequestUserBudgetsRequest, opts ...grpc.CallOption) (*RequestUserBudgetsResponse, error)
+	AddRecurring(ctx context.Context, in *AddRecurringRequest, opts ...grpc.CallOption) (*AddRecurringResponse, error)
+	RequestUserRecurring(ctx context.Context, in *RequestUserRecurringRequest, opts ...grpc.CallOption) (*RequestUserRecurringResponse, error)
+	SetRecurringPaused(ctx context.Context, in *SetRecurringPausedRequest, opts ...grpc.CallOption) (*SetRecurringPausedResponse, error)
+	DeleteRecurring(ctx context.Context, in *DeleteRecurringRequest, opts ...grpc.CallOption) (*DeleteRecurringResponse, error)
+	RequestDueRecurring(ctx context.Context, in *RequestDueRecurringRequest, opts ...grpc.CallOption) (*RequestDueRecurringResponse, error)
+	AdvanceRecurring(ctx context.Context, in *AdvanceRecurringRequest, opts ...grpc.CallOption) (*AdvanceRecurringResponse, error)
+	MaterializeRecurring(ctx context.Context, in *MaterializeRecurringRequest, opts ...grpc.CallOption) (*MaterializeRecurringResponse, error)
+	RequestUserStats(ctx context.Context, in *RequestUserStatsRequest, opts ...grpc.CallOption) (*RequestUserStatsResponse, error)
+	AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*AddAccountResponse, error)
+	EditAccount(ctx context.Context, in *EditAccountRequest, opts ...grpc.CallOption) (*EditAccountResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	RequestUserAccounts(ctx context.Context, in *RequestUserAccountsRequest, opts ...grpc.CallOption) (*RequestUserAccountsResponse, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	LoadExchangeRates(ctx context.Context, in *LoadExchangeRatesRequest, opts ...grpc.CallOption) (*LoadExchangeRatesResponse, error)
+	AddChatMessages(ctx context.Context, in *AddChatMessagesRequest, opts ...grpc.CallOption) (*AddChatMessagesResponse, error)
+	RequestChatMessages(ctx context.Context, in *RequestChatMessagesRequest, opts ...grpc.CallOption) (*RequestChatMessagesResponse, error)
+	ClearChatMessages(ctx context.Context, in *ClearChatMessagesRequest, opts ...grpc.CallOption) (*ClearChatMessagesResponse, error)
+	AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error)
+	EditCategory(ctx context.Context, in *EditCategoryRequest, opts ...grpc.CallOption) (*EditCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	RequestUserCategories(ctx context.Context, in *RequestUserCategoriesRequest, opts ...grpc.CallOption) (*RequestUserCategoriesResponse, error)
+	AddRule(ctx context.Context, in *AddRuleRequest, opts ...grpc.CallOption) (*AddRuleResponse, error)
+	EditRule(ctx context.Context, in *EditRuleRequest, opts ...grpc.CallOption) (*EditRuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error)
+	RequestUserRules(ctx context.Context, in *RequestUserRulesRequest, opts ...grpc.CallOption) (*RequestUserRulesResponse, error)
+	RequestCategoryCorrections(ctx context.Context, in *RequestCategoryCorrectionsRequest, opts ...grpc.CallOption) (*RequestCategoryCorrectionsResponse, error)
+	ClaimCategorizationJobs(ctx context.Context, in *ClaimCategorizationJobsRequest, opts ...grpc.CallOption) (*ClaimCategorizationJobsResponse, error)
+	CompleteCategorizationJob(ctx context.Context, in *CompleteCategorizationJobRequest, opts ...grpc.CallOption) (*CompleteCategorizationJobResponse, error)
+	FailCategorizationJob(ctx context.Context, in *FailCategorizationJobRequest, opts ...grpc.CallOption) (*FailCategorizationJobResponse, error)
+	RequestRateLimit(ctx context.Context, in *RequestRateLimitRequest, opts ...grpc.CallOption) (*RequestRateLimitResponse, error)
+	SwapRateLimit(ctx context.Context, in *SwapRateLimitRequest, opts ...grpc.CallOption) (*SwapRateLimitResponse, error)
+	StartRecategorization(ctx context.Context, in *StartRecategorizationRequest, opts ...grpc.CallOption) (*StartRecategorizationResponse, error)
+	UpdateRecategorization(ctx context.Context, in *UpdateRecategorizationRequest, opts ...grpc.CallOption) (*UpdateRecategorizationResponse, error)
+	RequestRecategorization(ctx context.Context, in *RequestRecategorizationRequest, opts ...grpc.CallOption) (*RequestRecategorizationResponse, error)
+}
+
+type databaseClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseClient(cc grpc.ClientConnInterface) DatabaseClient {
+	return &databaseClient{cc}
+}
+
+func (c *databaseClient) AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddUserResponse)
+	err := c.cc.Invoke(ctx, Database_AddUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionResponse)
+	err := c.cc.Invoke(ctx, Database_AddTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddTransactions(ctx context.Context, in *AddTransactionsRequest, opts ...grpc.CallOption) (*AddTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionsResponse)
+	err := c.cc.Invoke(ctx, Database_AddTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditTransaction(ctx context.Context, in *EditTransactionRequest, opts ...grpc.CallOption) (*EditTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditTransactionResponse)
+	err := c.cc.Invoke(ctx, Database_EditTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransactionResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserTransactions(ctx context.Context, in *RequestUserTransactionsRequest, opts ...grpc.CallOption) (*RequestUserTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserTransactionsResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetResponse)
+	err := c.cc.Invoke(ctx, Database_SetBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserBudgets(ctx context.Context, in *RequestUserBudgetsRequest, opts ...grpc.CallOption) (*RequestUserBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserBudgetsResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddRecurring(ctx context.Context, in *AddRecurringRequest, opts ...grpc.CallOption) (*AddRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_AddRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserRecurring(ctx context.Context, in *RequestUserRecurringRequest, opts ...grpc.CallOption) (*RequestUserRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SetRecurringPaused(ctx context.Context, in *SetRecurringPausedRequest, opts ...grpc.CallOption) (*SetRecurringPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecurringPausedResponse)
+	err := c.cc.Invoke(ctx, Database_SetRecurringPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteRecurring(ctx context.Context, in *DeleteRecurringRequest, opts ...grpc.CallOption) (*DeleteRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestDueRecurring(ctx context.Context, in *RequestDueRecurringRequest, opts ...grpc.CallOption) (*RequestDueRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDueRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_RequestDueRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AdvanceRecurring(ctx context.Context, in *AdvanceRecurringRequest, opts ...grpc.CallOption) (*AdvanceRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdvanceRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_AdvanceRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) MaterializeRecurring(ctx context.Context, in *MaterializeRecurringRequest, opts ...grpc.CallOption) (*MaterializeRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterializeRecurringResponse)
+	err := c.cc.Invoke(ctx, Database_MaterializeRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserStats(ctx context.Context, in *RequestUserStatsRequest, opts ...grpc.CallOption) (*RequestUserStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserStatsResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddAccount(ctx context.Context, in *AddAccountRequest, opts ...grpc.CallOption) (*AddAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAccountResponse)
+	err := c.cc.Invoke(ctx, Database_AddAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditAccount(ctx context.Context, in *EditAccountRequest, opts ...grpc.CallOption) (*EditAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditAccountResponse)
+	err := c.cc.Invoke(ctx, Database_EditAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserAccounts(ctx context.Context, in *RequestUserAccountsRequest, opts ...grpc.CallOption) (*RequestUserAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserAccountsResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, Database_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) LoadExchangeRates(ctx context.Context, in *LoadExchangeRatesRequest, opts ...grpc.CallOption) (*LoadExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoadExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Database_LoadExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddChatMessages(ctx context.Context, in *AddChatMessagesRequest, opts ...grpc.CallOption) (*AddChatMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddChatMessagesResponse)
+	err := c.cc.Invoke(ctx, Database_AddChatMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestChatMessages(ctx context.Context, in *RequestChatMessagesRequest, opts ...grpc.CallOption) (*RequestChatMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestChatMessagesResponse)
+	err := c.cc.Invoke(ctx, Database_RequestChatMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ClearChatMessages(ctx context.Context, in *ClearChatMessagesRequest, opts ...grpc.CallOption) (*ClearChatMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearChatMessagesResponse)
+	err := c.cc.Invoke(ctx, Database_ClearChatMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCategoryResponse)
+	err := c.cc.Invoke(ctx, Database_AddCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditCategory(ctx context.Context, in *EditCategoryRequest, opts ...grpc.CallOption) (*EditCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCategoryResponse)
+	err := c.cc.Invoke(ctx, Database_EditCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserCategories(ctx context.Context, in *RequestUserCategoriesRequest, opts ...grpc.CallOption) (*RequestUserCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserCategoriesResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) AddRule(ctx context.Context, in *AddRuleRequest, opts ...grpc.CallOption) (*AddRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRuleResponse)
+	err := c.cc.Invoke(ctx, Database_AddRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) EditRule(ctx context.Context, in *EditRuleRequest, opts ...grpc.CallOption) (*EditRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditRuleResponse)
+	err := c.cc.Invoke(ctx, Database_EditRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRuleResponse)
+	err := c.cc.Invoke(ctx, Database_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestUserRules(ctx context.Context, in *RequestUserRulesRequest, opts ...grpc.CallOption) (*RequestUserRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserRulesResponse)
+	err := c.cc.Invoke(ctx, Database_RequestUserRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestCategoryCorrections(ctx context.Context, in *RequestCategoryCorrectionsRequest, opts ...grpc.CallOption) (*RequestCategoryCorrectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestCategoryCorrectionsResponse)
+	err := c.cc.Invoke(ctx, Database_RequestCategoryCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) ClaimCategorizationJobs(ctx context.Context, in *ClaimCategorizationJobsRequest, opts ...grpc.CallOption) (*ClaimCategorizationJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimCategorizationJobsResponse)
+	err := c.cc.Invoke(ctx, Database_ClaimCategorizationJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) CompleteCategorizationJob(ctx context.Context, in *CompleteCategorizationJobRequest, opts ...grpc.CallOption) (*CompleteCategorizationJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteCategorizationJobResponse)
+	err := c.cc.Invoke(ctx, Database_CompleteCategorizationJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) FailCategorizationJob(ctx context.Context, in *FailCategorizationJobRequest, opts ...grpc.CallOption) (*FailCategorizationJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FailCategorizationJobResponse)
+	err := c.cc.Invoke(ctx, Database_FailCategorizationJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestRateLimit(ctx context.Context, in *RequestRateLimitRequest, opts ...grpc.CallOption) (*RequestRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRateLimitResponse)
+	err := c.cc.Invoke(ctx, Database_RequestRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) SwapRateLimit(ctx context.Context, in *SwapRateLimitRequest, opts ...grpc.CallOption) (*SwapRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapRateLimitResponse)
+	err := c.cc.Invoke(ctx, Database_SwapRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) StartRecategorization(ctx context.Context, in *StartRecategorizationRequest, opts ...grpc.CallOption) (*StartRecategorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartRecategorizationResponse)
+	err := c.cc.Invoke(ctx, Database_StartRecategorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) UpdateRecategorization(ctx context.Context, in *UpdateRecategorizationRequest, opts ...grpc.CallOption) (*UpdateRecategorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecategorizationResponse)
+	err := c.cc.Invoke(ctx, Database_UpdateRecategorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseClient) RequestRecategorization(ctx context.Context, in *RequestRecategorizationRequest, opts ...grpc.CallOption) (*RequestRecategorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRecategorizationResponse)
+	err := c.cc.Invoke(ctx, Database_RequestRecategorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseServer is the server API for Database service.
+// All implementations must embed UnimplementedDatabaseServer
+// for forward compatibility.
+type DatabaseServer interface {
+	AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
+	AddTransactions(context.Context, *AddTransactionsRequest) (*AddTransactionsResponse, error)
+	EditTransaction(context.Context, *EditTransactionRequest) (*EditTransactionResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	RequestUserTransactions(context.Context, *RequestUserTransactionsRequest) (*RequestUserTransactionsResponse, error)
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	RequestUserBudgets(context.Context, *RequestUserBudgetsRequest) (*RequestUserBudgetsResponse, error)
+	AddRecurring(context.Context, *AddRecurringRequest) (*AddRecurringResponse, error)
+	RequestUserRecurring(context.Context, *RequestUserRecurringRequest) (*RequestUserRecurringResponse, error)
+	SetRecurringPaused(context.Context, *SetRecurringPausedRequest) (*SetRecurringPausedResponse, error)
+	DeleteRecurring(context.Context, *DeleteRecurringRequest) (*DeleteRecurringResponse, error)
+	RequestDueRecurring(context.Context, *RequestDueRecurringRequest) (*RequestDueRecurringResponse, error)
+	AdvanceRecurring(context.Context, *AdvanceRecurringRequest) (*AdvanceRecurringResponse, error)
+	MaterializeRecurring(context.Context, *MaterializeRecurringRequest) (*MaterializeRecurringResponse, error)
+	RequestUserStats(context.Context, *RequestUserStatsRequest) (*RequestUserStatsResponse, error)
+	AddAccount(context.Context, *AddAccountRequest) (*AddAccountResponse, error)
+	EditAccount(context.Context, *EditAccountRequest) (*EditAccountResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	RequestUserAccounts(context.Context, *RequestUserAccountsRequest) (*RequestUserAccountsResponse, error)
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	LoadExchangeRates(context.Context, *LoadExchangeRatesRequest) (*LoadExchangeRatesResponse, error)
+	AddChatMessages(context.Context, *AddChatMessagesRequest) (*AddChatMessagesResponse, error)
+	RequestChatMessages(context.Context, *RequestChatMessagesRequest) (*RequestChatMessagesResponse, error)
+	ClearChatMessages(context.Context, *ClearChatMessagesRequest) (*ClearChatMessagesResponse, error)
+	AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryResponse, error)
+	EditCategory(context.Context, *EditCategoryRequest) (*EditCategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	RequestUserCategories(context.Context, *RequestUserCategoriesRequest) (*RequestUserCategoriesResponse, error)
+	AddRule(context.Context, *AddRuleRequest) (*AddRuleResponse, error)
+	EditRule(context.Context, *EditRuleRequest) (*EditRuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error)
+	RequestUserRules(context.Context, *RequestUserRulesRequest) (*RequestUserRulesResponse, error)
+	RequestCategoryCorrections(context.Context, *RequestCategoryCorrectionsRequest) (*RequestCategoryCorrectionsResponse, error)
+	ClaimCategorizationJobs(context.Context, *ClaimCategorizationJobsRequest) (*ClaimCategorizationJobsResponse, error)
+	CompleteCategorizationJob(context.Context, *CompleteCategorizationJobRequest) (*CompleteCategorizationJobResponse, error)
+	FailCategorizationJob(context.Context, *FailCategorizationJobRequest) (*FailCategorizationJobResponse, error)
+	RequestRateLimit(context.Context, *RequestRateLimitRequest) (*RequestRateLimitResponse, error)
+	SwapRateLimit(context.Context, *SwapRateLimitRequest) (*SwapRateLimitResponse, error)
+	StartRecategorization(context.Context, *StartRecategorizationRequest) (*StartRecategorizationResponse, error)
+	UpdateRecategorization(context.Context, *UpdateRecategorizationRequest) (*UpdateRecategorizationResponse, error)
+	RequestRecategorization(context.Context, *RequestRecategorizationRequest) (*RequestRecategorizationResponse, error)
+	mustEmbedUnimplementedDatabaseServer()
+}
+
+// UnimplementedDatabaseServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDatabaseServer struct{}
+
+func (UnimplementedDatabaseServer) AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedDatabaseServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransaction not implemented")
+}
+func (UnimplementedDatabaseServer) AddTransactions(context.Context, *AddTransactionsRequest) (*AddTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransactions not implemented")
+}
+func (UnimplementedDatabaseServer) EditTransaction(context.Context, *EditTransactionRequest) (*EditTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTransaction not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserTransactions(context.Context, *RequestUserTransactionsRequest) (*RequestUserTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserTransactions not implemented")
+}
+func (UnimplementedDatabaseServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserBudgets(context.Context, *RequestUserBudgetsRequest) (*RequestUserBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserBudgets not implemented")
+}
+func (UnimplementedDatabaseServer) AddRecurring(context.Context, *AddRecurringRequest) (*AddRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserRecurring(context.Context, *RequestUserRecurringRequest) (*RequestUserRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) SetRecurringPaused(context.Context, *SetRecurringPausedRequest) (*SetRecurringPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecurringPaused not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteRecurring(context.Context, *DeleteRecurringRequest) (*DeleteRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) RequestDueRecurring(context.Context, *RequestDueRecurringRequest) (*RequestDueRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDueRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) AdvanceRecurring(context.Context, *AdvanceRecurringRequest) (*AdvanceRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) MaterializeRecurring(context.Context, *MaterializeRecurringRequest) (*MaterializeRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaterializeRecurring not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserStats(context.Context, *RequestUserStatsRequest) (*RequestUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserStats not implemented")
+}
+func (UnimplementedDatabaseServer) AddAccount(context.Context, *AddAccountRequest) (*AddAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccount not implemented")
+}
+func (UnimplementedDatabaseServer) EditAccount(context.Context, *EditAccountRequest) (*EditAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAccount not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserAccounts(context.Context, *RequestUserAccountsRequest) (*RequestUserAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserAccounts not implemented")
+}
+func (UnimplementedDatabaseServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedDatabaseServer) LoadExchangeRates(context.Context, *LoadExchangeRatesRequest) (*LoadExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadExchangeRates not implemented")
+}
+func (UnimplementedDatabaseServer) AddChatMessages(context.Context, *AddChatMessagesRequest) (*AddChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMessages not implemented")
+}
+func (UnimplementedDatabaseServer) RequestChatMessages(context.Context, *RequestChatMessagesRequest) (*RequestChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestChatMessages not implemented")
+}
+func (UnimplementedDatabaseServer) ClearChatMessages(context.Context, *ClearChatMessagesRequest) (*ClearChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearChatMessages not implemented")
+}
+func (UnimplementedDatabaseServer) AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
+func (UnimplementedDatabaseServer) EditCategory(context.Context, *EditCategoryRequest) (*EditCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCategory not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserCategories(context.Context, *RequestUserCategoriesRequest) (*RequestUserCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserCategories not implemented")
+}
+func (UnimplementedDatabaseServer) AddRule(context.Context, *AddRuleRequest) (*AddRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
+func (UnimplementedDatabaseServer) EditRule(context.Context, *EditRuleRequest) (*EditRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRule not implemented")
+}
+func (UnimplementedDatabaseServer) DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedDatabaseServer) RequestUserRules(context.Context, *RequestUserRulesRequest) (*RequestUserRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserRules not implemented")
+}
+func (UnimplementedDatabaseServer) RequestCategoryCorrections(context.Context, *RequestCategoryCorrectionsRequest) (*RequestCategoryCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCategoryCorrections not implemented")
+}
+func (UnimplementedDatabaseServer) ClaimCategorizationJobs(context.Context, *ClaimCategorizationJobsRequest) (*ClaimCategorizationJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCategorizationJobs not implemented")
+}
+func (UnimplementedDatabaseServer) CompleteCategorizationJob(context.Context, *CompleteCategorizationJobRequest) (*CompleteCategorizationJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCategorizationJob not implemented")
+}
+func (UnimplementedDatabaseServer) FailCategorizationJob(context.Context, *FailCategorizationJobRequest) (*FailCategorizationJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailCategorizationJob not implemented")
+}
+func (UnimplementedDatabaseServer) RequestRateLimit(context.Context, *RequestRateLimitRequest) (*RequestRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRateLimit not implemented")
+}
+func (UnimplementedDatabaseServer) SwapRateLimit(context.Context, *SwapRateLimitRequest) (*SwapRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRateLimit not implemented")
+}
+func (UnimplementedDatabaseServer) StartRecategorization(context.Context, *StartRecategorizationRequest) (*StartRecategorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecategorization not implemented")
+}
+func (UnimplementedDatabaseServer) UpdateRecategorization(context.Context, *UpdateRecategorizationRequest) (*UpdateRecategorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecategorization not implemented")
+}
+func (UnimplementedDatabaseServer) RequestRecategorization(context.Context, *RequestRecategorizationRequest) (*RequestRecategorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRecategorization not implemented")
+}
+func (UnimplementedDatabaseServer) mustEmbedUnimplementedDatabaseServer() {}
+func (UnimplementedDatabaseServer) testEmbeddedByValue()                  {}
+
+// UnsafeDatabaseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseServer will
+// result in compilation errors.
+type UnsafeDatabaseServer interface {
+	mustEmbedUnimplementedDatabaseServer()
+}
+
+func RegisterDatabaseServer(s grpc.ServiceRegistrar, srv DatabaseServer) {
+	// If the following call pancis, it indicates UnimplementedDatabaseServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Database_ServiceDesc, srv)
+}
+
+func _Database_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddUser(ctx, req.(*AddUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddTransactions(ctx, req.(*AddTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_EditTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_EditTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditTransaction(ctx, req.(*EditTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserTransactions(ctx, req.(*RequestUserTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserBudgets(ctx, req.(*RequestUserBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddRecurring(ctx, req.(*AddRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserRecurring(ctx, req.(*RequestUserRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SetRecurringPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecurringPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SetRecurringPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_SetRecurringPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SetRecurringPaused(ctx, req.(*SetRecurringPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteRecurring(ctx, req.(*DeleteRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestDueRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDueRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestDueRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestDueRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestDueRecurring(ctx, req.(*RequestDueRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AdvanceRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AdvanceRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AdvanceRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AdvanceRecurring(ctx, req.(*AdvanceRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_MaterializeRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaterializeRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).MaterializeRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_MaterializeRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).MaterializeRecurring(ctx, req.(*MaterializeRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserStats(ctx, req.(*RequestUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddAccount(ctx, req.(*AddAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_EditAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_EditAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditAccount(ctx, req.(*EditAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserAccounts(ctx, req.(*RequestUserAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_LoadExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).LoadExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_LoadExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).LoadExchangeRates(ctx, req.(*LoadExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddChatMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddChatMessages(ctx, req.(*AddChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestChatMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestChatMessages(ctx, req.(*RequestChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ClearChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ClearChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_ClearChatMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ClearChatMessages(ctx, req.(*ClearChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddCategory(ctx, req.(*AddCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_EditCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_EditCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditCategory(ctx, req.(*EditCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserCategories(ctx, req.(*RequestUserCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_AddRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).AddRule(ctx, req.(*AddRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_EditRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).EditRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_EditRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).EditRule(ctx, req.(*EditRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestUserRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestUserRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestUserRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestUserRules(ctx, req.(*RequestUserRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestCategoryCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCategoryCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestCategoryCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestCategoryCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestCategoryCorrections(ctx, req.(*RequestCategoryCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_ClaimCategorizationJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCategorizationJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).ClaimCategorizationJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_ClaimCategorizationJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).ClaimCategorizationJobs(ctx, req.(*ClaimCategorizationJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_CompleteCategorizationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCategorizationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).CompleteCategorizationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_CompleteCategorizationJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).CompleteCategorizationJob(ctx, req.(*CompleteCategorizationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_FailCategorizationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailCategorizationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).FailCategorizationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_FailCategorizationJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).FailCategorizationJob(ctx, req.(*FailCategorizationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestRateLimit(ctx, req.(*RequestRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_SwapRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).SwapRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_SwapRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).SwapRateLimit(ctx, req.(*SwapRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_StartRecategorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRecategorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).StartRecategorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_StartRecategorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).StartRecategorization(ctx, req.(*StartRecategorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_UpdateRecategorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecategorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).UpdateRecategorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_UpdateRecategorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).UpdateRecategorization(ctx, req.(*UpdateRecategorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Database_RequestRecategorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRecategorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServer).RequestRecategorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Database_RequestRecategorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServer).RequestRecategorization(ctx, req.(*RequestRecategorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Database_ServiceDesc is the grpc.ServiceDesc for Database service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Database_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cyberdatabase.Database",
+	HandlerType: (*DatabaseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddUser",
+			Handler:    _Database_AddUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Database_DeleteUser_Handler,
+		},
+		{
+			MethodName: "AddTransaction",
+			Handler:    _Database_AddTransaction_Handler,
+		},
+		{
+			MethodName: "AddTransactions",
+			Handler:    _Database_AddTransactions_Handler,
+		},
+		{
+			MethodName: "EditTransaction",
+			Handler:    _Database_EditTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _Database_DeleteTransaction_Handler,
+		},
+		{
+			MethodName: "RequestUserTransactions",
+			Handler:    _Database_RequestUserTransactions_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _Database_SetBudget_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _Database_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "RequestUserBudgets",
+			Handler:    _Database_RequestUserBudgets_Handler,
+		},
+		{
+			MethodName: "AddRecurring",
+			Handler:    _Database_AddRecurring_Handler,
+		},
+		{
+			MethodName: "RequestUserRecurring",
+			Handler:    _Database_RequestUserRecurring_Handler,
+		},
+		{
+			MethodName: "SetRecurringPaused",
+			Handler:    _Database_SetRecurringPaused_Handler,
+		},
+		{
+			MethodName: "DeleteRecurring",
+			Handler:    _Database_DeleteRecurring_Handler,
+		},
+		{
+			MethodName: "RequestDueRecurring",
+			Handler:    _Database_RequestDueRecurring_Handler,
+		},
+		{
+			MethodName: "AdvanceRecurring",
+			Handler:    _Database_AdvanceRecurring_Handler,
+		},
+		{
+			MethodName: "MaterializeRecurring",
+			Handler:    _Database_MaterializeRecurring_Handler,
+		},
+		{
+			MethodName: "RequestUserStats",
+			Handler:    _Database_RequestUserStats_Handler,
+		},
+		{
+			MethodName: "AddAccount",
+			Handler:    _Database_AddAccount_Handler,
+		},
+		{
+			MethodName: "EditAccount",
+			Handler:    _Database_EditAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Database_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RequestUserAccounts",
+			Handler:    _Database_RequestUserAccounts_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Database_Transfer_Handler,
+		},
+		{
+			MethodName: "LoadExchangeRates",
+			Handler:    _Database_LoadExchangeRates_Handler,
+		},
+		{
+			MethodName: "AddChatMessages",
+			Handler:    _Database_AddChatMessages_Handler,
+		},
+		{
+			MethodName: "RequestChatMessages",
+			Handler:    _Database_RequestChatMessages_Handler,
+		},
+		{
+			MethodName: "ClearChatMessages",
+			Handler:    _Database_ClearChatMessages_Handler,
+		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _Database_AddCategory_Handler,
+		},
+		{
+			MethodName: "EditCategory",
+			Handler:    _Database_EditCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Database_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "RequestUserCategories",
+			Handler:    _Database_RequestUserCategories_Handler,
+		},
+		{
+			MethodName: "AddRule",
+			Handler:    _Database_AddRule_Handler,
+		},
+		{
+			MethodName: "EditRule",
+			Handler:    _Database_EditRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _Database_DeleteRule_Handler,
+		},
+		{
+			MethodName: "RequestUserRules",
+			Handler:    _Database_RequestUserRules_Handler,
+		},
+		{
+			MethodName: "RequestCategoryCorrections",
+			Handler:    _Database_RequestCategoryCorrections_Handler,
+		},
+		{
+			MethodName: "ClaimCategorizationJobs",
+			Handler:    _Database_ClaimCategorizationJobs_Handler,
+		},
+		{
+			MethodName: "CompleteCategorizationJob",
+			Handler:    _Database_CompleteCategorizationJob_Handler,
+		},
+		{
+			MethodName: "FailCategorizationJob",
+			Handler:    _Database_FailCategorizationJob_Handler,
+		},
+		{
+			MethodName: "RequestRateLimit",
+			Handler:    _Database_RequestRateLimit_Handler,
+		},
+		{
+			MethodName: "SwapRateLimit",
+			Handler:    _Database_SwapRateLimit_Handler,
+		},
+		{
+			MethodName: "StartRecategorization",
+			Handler:    _Database_StartRecategorization_Handler,
+		},
+		{
+			MethodName: "UpdateRecategorization",
+			Handler:    _Database_UpdateRecategorization_Handler,
+		},
+		{
+			MethodName: "RequestRecategorization",
+			Handler:    _Database_RequestRecategorization_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cybergarden/database/database.proto",
+}
@@ -0,0 +1,17 @@
+module github.com/PrototypeSirius/protos_service
+
+go 1.24.2
+
+require (
+	github.com/PrototypeSirius/ruglogger v0.0.0-20251207014209-be4793c87eb3
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251022142026-3a174f9686a8
+	google.golang.org/grpc v1.77.0
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	github.com/sirupsen/logrus v1.9.3 // indirect
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+)
@@ -0,0 +1,72 @@
+// Package grpcstatus sends the application errors of the gRPC services as
+// gRPC statuses, the same way in every service.
+package grpcstatus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/PrototypeSirius/ruglogger/apperror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor sends application errors as gRPC statuses. The HTTP
+// status of the error picks the code, the public message becomes the status
+// message and the app code travels in an ErrorInfo detail of domain.
+func UnaryServerInterceptor(domain string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, FromError(err, domain)
+		}
+		return resp, nil
+	}
+}
+
+// FromError converts err to a gRPC status error. Statuses pass through and
+// any other error becomes Internal without its text.
+func FromError(err error, domain string) error {
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, "Internal Server Error")
+	}
+	st := status.New(Code(appErr.HTTPStatus), appErr.Message)
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "APP_ERROR",
+		Domain:   domain,
+		Metadata: map[string]string{"app_code": strconv.Itoa(appErr.AppCode)},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Code is the gRPC code of an HTTP status.
+func Code(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}